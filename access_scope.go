@@ -0,0 +1,67 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+)
+
+const accessScopesBasePath = "admin/oauth/access_scopes"
+
+// AccessScopeAPI is an interface for interfacing with the access scope
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/en/api/reference/access/accessscope
+type AccessScopeAPI interface {
+	List(interface{}) ([]AccessScope, error)
+	ListWithContext(context.Context, interface{}) ([]AccessScope, error)
+	Granted() (Scopes, error)
+	GrantedWithContext(context.Context) (Scopes, error)
+}
+
+// AccessScopeAPIOp handles communication with the access scope related
+// methods of the Shopify API.
+type AccessScopeAPIOp struct {
+	client *Client
+}
+
+// AccessScope represents a scope granted to the app by a shop.
+type AccessScope struct {
+	Handle string `json:"handle"`
+}
+
+// AccessScopesResource is the root object for an access scopes get request.
+type AccessScopesResource struct {
+	AccessScopes []AccessScope `json:"access_scopes"`
+}
+
+// List the access scopes granted to the app
+func (s *AccessScopeAPIOp) List(options interface{}) ([]AccessScope, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *AccessScopeAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]AccessScope, error) {
+	path := fmt.Sprintf("%s.json", accessScopesBasePath)
+	resource := new(AccessScopesResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.AccessScopes, err
+}
+
+// Granted returns the scopes granted to the app, e.g. to compare them with
+// the app's Scopes.
+func (s *AccessScopeAPIOp) Granted() (Scopes, error) {
+	return s.GrantedWithContext(context.Background())
+}
+
+// GrantedWithContext is the context-aware variant of Granted.
+func (s *AccessScopeAPIOp) GrantedWithContext(ctx context.Context) (Scopes, error) {
+	accessScopes, err := s.ListWithContext(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make(Scopes, 0, len(accessScopes))
+	for _, accessScope := range accessScopes {
+		scopes = append(scopes, accessScope.Handle)
+	}
+	return scopes, nil
+}
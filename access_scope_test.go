@@ -0,0 +1,62 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestAccessScopeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/oauth/access_scopes.json",
+		httpmock.NewBytesResponder(200, loadFixture("access_scopes.json")))
+
+	accessScopes, err := client.AccessScope.List(nil)
+	if err != nil {
+		t.Errorf("AccessScope.List returned error: %v", err)
+	}
+
+	expected := []AccessScope{{Handle: "read_products"}, {Handle: "write_orders"}}
+	if !reflect.DeepEqual(accessScopes, expected) {
+		t.Errorf("AccessScope.List returned %+v, expected %+v", accessScopes, expected)
+	}
+}
+
+func TestAccessScopeGranted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/oauth/access_scopes.json",
+		httpmock.NewBytesResponder(200, loadFixture("access_scopes.json")))
+
+	scopes, err := client.AccessScope.Granted()
+	if err != nil {
+		t.Errorf("AccessScope.Granted returned error: %v", err)
+	}
+
+	expected := Scopes{"read_products", "write_orders"}
+	if !reflect.DeepEqual(scopes, expected) {
+		t.Errorf("AccessScope.Granted returned %+v, expected %+v", scopes, expected)
+	}
+}
+
+func TestAccessScopeGrantedVersioned(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Access scopes are not versioned
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/oauth/access_scopes.json",
+		httpmock.NewBytesResponder(200, loadFixture("access_scopes.json")))
+
+	versionedApp := app
+	versionedApp.APIVersion = "2019-04"
+	versionedClient := mustNewClient(versionedApp, "fooshop", "abcd")
+
+	_, err := versionedClient.AccessScope.Granted()
+	if err != nil {
+		t.Errorf("AccessScope.Granted returned error: %v", err)
+	}
+}
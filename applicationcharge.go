@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,9 +15,13 @@ const applicationChargesBasePath = "admin/application_charges"
 // See https://help.shopify.com/api/reference/billing/applicationcharge
 type ApplicationChargeAPI interface {
 	Create(ApplicationCharge) (*ApplicationCharge, error)
+	CreateWithContext(context.Context, ApplicationCharge) (*ApplicationCharge, error)
 	Get(int, interface{}) (*ApplicationCharge, error)
+	GetWithContext(context.Context, int, interface{}) (*ApplicationCharge, error)
 	List(interface{}) ([]ApplicationCharge, error)
+	ListWithContext(context.Context, interface{}) ([]ApplicationCharge, error)
 	Activate(ApplicationCharge) (*ApplicationCharge, error)
+	ActivateWithContext(context.Context, ApplicationCharge) (*ApplicationCharge, error)
 }
 
 // ApplicationChargeAPIOp application charge service op
@@ -54,28 +59,48 @@ type ApplicationChargesResource struct {
 
 // Create creates new application charge.
 func (a *ApplicationChargeAPIOp) Create(charge ApplicationCharge) (*ApplicationCharge, error) {
+	return a.CreateWithContext(context.Background(), charge)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (a *ApplicationChargeAPIOp) CreateWithContext(ctx context.Context, charge ApplicationCharge) (*ApplicationCharge, error) {
 	path := fmt.Sprintf("%s.json", applicationChargesBasePath)
 	resource := &ApplicationChargeResource{}
-	return resource.Charge, a.client.Post(path, ApplicationChargeResource{Charge: &charge}, resource)
+	return resource.Charge, a.client.PostWithContext(ctx, path, ApplicationChargeResource{Charge: &charge}, resource)
 }
 
 // Get gets individual application charge.
 func (a *ApplicationChargeAPIOp) Get(chargeID int, options interface{}) (*ApplicationCharge, error) {
+	return a.GetWithContext(context.Background(), chargeID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (a *ApplicationChargeAPIOp) GetWithContext(ctx context.Context, chargeID int, options interface{}) (*ApplicationCharge, error) {
 	path := fmt.Sprintf("%s/%d.json", applicationChargesBasePath, chargeID)
 	resource := &ApplicationChargeResource{}
-	return resource.Charge, a.client.Get(path, resource, options)
+	return resource.Charge, a.client.GetWithContext(ctx, path, resource, options)
 }
 
 // List gets all application charges.
 func (a *ApplicationChargeAPIOp) List(options interface{}) ([]ApplicationCharge, error) {
+	return a.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (a *ApplicationChargeAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]ApplicationCharge, error) {
 	path := fmt.Sprintf("%s.json", applicationChargesBasePath)
 	resource := &ApplicationChargesResource{}
-	return resource.Charges, a.client.Get(path, resource, options)
+	return resource.Charges, a.client.GetWithContext(ctx, path, resource, options)
 }
 
 // Activate activates application charge.
 func (a *ApplicationChargeAPIOp) Activate(charge ApplicationCharge) (*ApplicationCharge, error) {
+	return a.ActivateWithContext(context.Background(), charge)
+}
+
+// ActivateWithContext is the context-aware variant of Activate.
+func (a *ApplicationChargeAPIOp) ActivateWithContext(ctx context.Context, charge ApplicationCharge) (*ApplicationCharge, error) {
 	path := fmt.Sprintf("%s/%d/activate.json", applicationChargesBasePath, charge.ID)
 	resource := &ApplicationChargeResource{}
-	return resource.Charge, a.client.Post(path, ApplicationChargeResource{Charge: &charge}, resource)
+	return resource.Charge, a.client.PostWithContext(ctx, path, ApplicationChargeResource{Charge: &charge}, resource)
 }
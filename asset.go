@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -12,9 +13,13 @@ const assetsBasePath = "admin/themes"
 // See: https://help.shopify.com/api/reference/asset
 type AssetAPI interface {
 	List(int, interface{}) ([]Asset, error)
+	ListWithContext(context.Context, int, interface{}) ([]Asset, error)
 	Get(int, string) (*Asset, error)
+	GetWithContext(context.Context, int, string) (*Asset, error)
 	Update(int, Asset) (*Asset, error)
+	UpdateWithContext(context.Context, int, Asset) (*Asset, error)
 	Delete(int, string) error
+	DeleteWithContext(context.Context, int, string) error
 }
 
 // AssetAPIOp handles communication with the asset related methods of
@@ -55,35 +60,55 @@ type assetGetOptions struct {
 
 // List the metadata for all assets in the given theme
 func (s *AssetAPIOp) List(themeID int, options interface{}) ([]Asset, error) {
+	return s.ListWithContext(context.Background(), themeID, options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *AssetAPIOp) ListWithContext(ctx context.Context, themeID int, options interface{}) ([]Asset, error) {
 	path := fmt.Sprintf("%s/%d/assets.json", assetsBasePath, themeID)
 	resource := new(AssetsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Assets, err
 }
 
 // Get an asset by key from the given theme
 func (s *AssetAPIOp) Get(themeID int, key string) (*Asset, error) {
+	return s.GetWithContext(context.Background(), themeID, key)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *AssetAPIOp) GetWithContext(ctx context.Context, themeID int, key string) (*Asset, error) {
 	path := fmt.Sprintf("%s/%d/assets.json", assetsBasePath, themeID)
 	options := assetGetOptions{
 		Key:     key,
 		ThemeID: themeID,
 	}
 	resource := new(AssetResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Asset, err
 }
 
 // Update an asset
 func (s *AssetAPIOp) Update(themeID int, asset Asset) (*Asset, error) {
+	return s.UpdateWithContext(context.Background(), themeID, asset)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *AssetAPIOp) UpdateWithContext(ctx context.Context, themeID int, asset Asset) (*Asset, error) {
 	path := fmt.Sprintf("%s/%d/assets.json", assetsBasePath, themeID)
 	wrappedData := AssetResource{Asset: &asset}
 	resource := new(AssetResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Asset, err
 }
 
 // Delete an asset
 func (s *AssetAPIOp) Delete(themeID int, key string) error {
+	return s.DeleteWithContext(context.Background(), themeID, key)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *AssetAPIOp) DeleteWithContext(ctx context.Context, themeID int, key string) error {
 	path := fmt.Sprintf("%s/%d/assets.json?asset[key]=%s", assetsBasePath, themeID, key)
-	return s.client.Delete(path)
+	return s.client.DeleteWithContext(ctx, path)
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -12,11 +13,21 @@ const blogsBasePath = "admin/blogs"
 // See: https://help.shopify.com/api/reference/online_store/blog
 type BlogAPI interface {
 	List(interface{}) ([]Blog, error)
+	ListWithContext(context.Context, interface{}) ([]Blog, error)
+	ListWithPagination(interface{}) ([]Blog, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Blog, *Pagination, error)
+	ListAll(interface{}) ([]Blog, error)
+	ListAllWithContext(context.Context, interface{}) ([]Blog, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Blog, error)
+	GetWithContext(context.Context, int, interface{}) (*Blog, error)
 	Create(Blog) (*Blog, error)
+	CreateWithContext(context.Context, Blog) (*Blog, error)
 	Update(Blog) (*Blog, error)
+	UpdateWithContext(context.Context, Blog) (*Blog, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 }
 
 // BlogAPIOp handles communication with the blog related methods of
@@ -52,45 +63,99 @@ type BlogResource struct {
 
 // List all blogs
 func (s *BlogAPIOp) List(options interface{}) ([]Blog, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *BlogAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Blog, error) {
 	path := fmt.Sprintf("%s.json", blogsBasePath)
 	resource := new(BlogsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Blogs, err
 }
 
+// ListWithPagination lists blogs and returns the pagination to request the
+// next and previous pages.
+func (s *BlogAPIOp) ListWithPagination(options interface{}) ([]Blog, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *BlogAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Blog, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", blogsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *BlogsResource) []Blog { return r.Blogs })
+}
+
+// ListAll lists all blogs, following the pagination from the page
+// requested by options to the last page.
+func (s *BlogAPIOp) ListAll(options interface{}) ([]Blog, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *BlogAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Blog, error) {
+	path := fmt.Sprintf("%s.json", blogsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *BlogsResource) []Blog { return r.Blogs })
+}
+
 // Count blogs
 func (s *BlogAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *BlogAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", blogsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get single blog
 func (s *BlogAPIOp) Get(blogID int, options interface{}) (*Blog, error) {
+	return s.GetWithContext(context.Background(), blogID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *BlogAPIOp) GetWithContext(ctx context.Context, blogID int, options interface{}) (*Blog, error) {
 	path := fmt.Sprintf("%s/%d.json", blogsBasePath, blogID)
 	resource := new(BlogResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Blog, err
 }
 
 // Create a new blog
 func (s *BlogAPIOp) Create(blog Blog) (*Blog, error) {
+	return s.CreateWithContext(context.Background(), blog)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *BlogAPIOp) CreateWithContext(ctx context.Context, blog Blog) (*Blog, error) {
 	path := fmt.Sprintf("%s.json", blogsBasePath)
 	wrappedData := BlogResource{Blog: &blog}
 	resource := new(BlogResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Blog, err
 }
 
 // Update an existing blog
 func (s *BlogAPIOp) Update(blog Blog) (*Blog, error) {
+	return s.UpdateWithContext(context.Background(), blog)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *BlogAPIOp) UpdateWithContext(ctx context.Context, blog Blog) (*Blog, error) {
 	path := fmt.Sprintf("%s/%d.json", blogsBasePath, blog.ID)
 	wrappedData := BlogResource{Blog: &blog}
 	resource := new(BlogResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Blog, err
 }
 
 // Delete an blog
 func (s *BlogAPIOp) Delete(blogID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", blogsBasePath, blogID))
+	return s.DeleteWithContext(context.Background(), blogID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *BlogAPIOp) DeleteWithContext(ctx context.Context, blogID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", blogsBasePath, blogID))
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,10 +16,15 @@ const checkoutsResourceName = "checkouts"
 // See: https://help.shopify.com/en/api/reference/sales-channels/checkout
 type CheckoutAPI interface {
 	Create(Checkout) (*Checkout, error)
+	CreateWithContext(context.Context, Checkout) (*Checkout, error)
 	Complete(string) (*Checkout, error)
+	CompleteWithContext(context.Context, string) (*Checkout, error)
 	Get(string) (*Checkout, error)
+	GetWithContext(context.Context, string) (*Checkout, error)
 	Update(Checkout) (*Checkout, error)
+	UpdateWithContext(context.Context, Checkout) (*Checkout, error)
 	GetShippingRates(string) ([]ShippingRate, error)
+	GetShippingRatesWithContext(context.Context, string) ([]ShippingRate, error)
 }
 
 // CheckoutAPIOp handles communication with the checkout related methods of
@@ -145,42 +151,67 @@ type ShippingRatesResource struct {
 
 // Create a new checkout
 func (s *CheckoutAPIOp) Create(checkout Checkout) (*Checkout, error) {
+	return s.CreateWithContext(context.Background(), checkout)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CheckoutAPIOp) CreateWithContext(ctx context.Context, checkout Checkout) (*Checkout, error) {
 	path := fmt.Sprintf("%s.json", checkoutsBasePath)
 	wrappedData := CheckoutResource{Checkout: &checkout}
 	resource := new(CheckoutResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Checkout, err
 }
 
 // Complete a checkout
 func (s *CheckoutAPIOp) Complete(token string) (*Checkout, error) {
+	return s.CompleteWithContext(context.Background(), token)
+}
+
+// CompleteWithContext is the context-aware variant of Complete.
+func (s *CheckoutAPIOp) CompleteWithContext(ctx context.Context, token string) (*Checkout, error) {
 	path := fmt.Sprintf("%s/%s/complete.json", checkoutsBasePath, token)
 	resource := new(CheckoutResource)
-	err := s.client.Post(path, nil, resource)
+	err := s.client.PostWithContext(ctx, path, nil, resource)
 	return resource.Checkout, err
 }
 
 // Get a checkout
 func (s *CheckoutAPIOp) Get(token string) (*Checkout, error) {
+	return s.GetWithContext(context.Background(), token)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CheckoutAPIOp) GetWithContext(ctx context.Context, token string) (*Checkout, error) {
 	path := fmt.Sprintf("%s/%s.json", checkoutsBasePath, token)
 	resource := new(CheckoutResource)
-	err := s.client.Get(path, resource, nil)
+	err := s.client.GetWithContext(ctx, path, resource, nil)
 	return resource.Checkout, err
 }
 
 // Update an existing checkout
 func (s *CheckoutAPIOp) Update(checkout Checkout) (*Checkout, error) {
+	return s.UpdateWithContext(context.Background(), checkout)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CheckoutAPIOp) UpdateWithContext(ctx context.Context, checkout Checkout) (*Checkout, error) {
 	path := fmt.Sprintf("%s/%s.json", checkoutsBasePath, checkout.Token)
 	wrappedData := CheckoutResource{Checkout: &checkout}
 	resource := new(CheckoutResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Checkout, err
 }
 
 // GetShippingRates get checkout shipping rates
 func (s *CheckoutAPIOp) GetShippingRates(token string) ([]ShippingRate, error) {
+	return s.GetShippingRatesWithContext(context.Background(), token)
+}
+
+// GetShippingRatesWithContext is the context-aware variant of GetShippingRates.
+func (s *CheckoutAPIOp) GetShippingRatesWithContext(ctx context.Context, token string) ([]ShippingRate, error) {
 	path := fmt.Sprintf("%s/%s/shipping_rates.json", checkoutsBasePath, token)
 	resource := new(ShippingRatesResource)
-	err := s.client.Get(path, resource, nil)
+	err := s.client.GetWithContext(ctx, path, resource, nil)
 	return resource.ShippingRates, err
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -12,7 +13,14 @@ const collectsBasePath = "admin/collects"
 // See: https://help.shopify.com/api/reference/products/collect
 type CollectAPI interface {
 	List(interface{}) ([]Collect, error)
+	ListWithContext(context.Context, interface{}) ([]Collect, error)
+	ListWithPagination(interface{}) ([]Collect, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Collect, *Pagination, error)
+	ListAll(interface{}) ([]Collect, error)
+	ListAllWithContext(context.Context, interface{}) ([]Collect, error)
+	Stream(context.Context, interface{}) (<-chan Collect, <-chan error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 }
 
 // CollectAPIOp handles communication with the collect related methods of
@@ -45,14 +53,86 @@ type CollectsResource struct {
 
 // List collects
 func (s *CollectAPIOp) List(options interface{}) ([]Collect, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CollectAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Collect, error) {
 	path := fmt.Sprintf("%s.json", collectsBasePath)
 	resource := new(CollectsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Collects, err
 }
 
+// ListWithPagination lists collects and returns the pagination to request the
+// next and previous pages.
+func (s *CollectAPIOp) ListWithPagination(options interface{}) ([]Collect, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *CollectAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Collect, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", collectsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *CollectsResource) []Collect { return r.Collects })
+}
+
+// ListAll lists all collects, following the pagination from the page
+// requested by options to the last page.
+func (s *CollectAPIOp) ListAll(options interface{}) ([]Collect, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *CollectAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Collect, error) {
+	path := fmt.Sprintf("%s.json", collectsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *CollectsResource) []Collect { return r.Collects })
+}
+
+// Stream lists all collects like ListAll, but sends them on the returned
+// channel while the following pages are fetched. Up to 250 collects are
+// buffered ahead of the receiver, plus the page being fetched. The error
+// channel receives the error that ended the listing, if any. Both channels
+// are closed when the listing ends.
+func (s *CollectAPIOp) Stream(ctx context.Context, options interface{}) (<-chan Collect, <-chan error) {
+	collects := make(chan Collect, streamBufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(collects)
+		defer close(errs)
+
+		path := fmt.Sprintf("%s.json", collectsBasePath)
+		pager := s.client.NewPager(path, options)
+		for pager.HasNext() {
+			resource := new(CollectsResource)
+			if err := pager.NextWithContext(ctx, resource); err != nil {
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+				errs <- err
+				return
+			}
+			for _, collect := range resource.Collects {
+				select {
+				case collects <- collect:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+
+	return collects, errs
+}
+
 // Count collects
 func (s *CollectAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *CollectAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", collectsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
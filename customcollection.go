@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -13,11 +14,21 @@ const customCollectionsResourceName = "collections"
 // See https://help.shopify.com/api/reference/customcollection
 type CustomCollectionAPI interface {
 	List(interface{}) ([]CustomCollection, error)
+	ListWithContext(context.Context, interface{}) ([]CustomCollection, error)
+	ListWithPagination(interface{}) ([]CustomCollection, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]CustomCollection, *Pagination, error)
+	ListAll(interface{}) ([]CustomCollection, error)
+	ListAllWithContext(context.Context, interface{}) ([]CustomCollection, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*CustomCollection, error)
+	GetWithContext(context.Context, int, interface{}) (*CustomCollection, error)
 	Create(CustomCollection) (*CustomCollection, error)
+	CreateWithContext(context.Context, CustomCollection) (*CustomCollection, error)
 	Update(CustomCollection) (*CustomCollection, error)
+	UpdateWithContext(context.Context, CustomCollection) (*CustomCollection, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 
 	// MetafieldsAPI used for CustomCollection resource to communicate with Metafields resource
 	MetafieldsAPI
@@ -57,82 +68,166 @@ type CustomCollectionsResource struct {
 
 // List custom collections
 func (s *CustomCollectionAPIOp) List(options interface{}) ([]CustomCollection, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomCollectionAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]CustomCollection, error) {
 	path := fmt.Sprintf("%s.json", customCollectionsBasePath)
 	resource := new(CustomCollectionsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Collections, err
 }
 
+// ListWithPagination lists custom collections and returns the pagination to request the
+// next and previous pages.
+func (s *CustomCollectionAPIOp) ListWithPagination(options interface{}) ([]CustomCollection, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *CustomCollectionAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]CustomCollection, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", customCollectionsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *CustomCollectionsResource) []CustomCollection { return r.Collections })
+}
+
+// ListAll lists all custom collections, following the pagination from the page
+// requested by options to the last page.
+func (s *CustomCollectionAPIOp) ListAll(options interface{}) ([]CustomCollection, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *CustomCollectionAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]CustomCollection, error) {
+	path := fmt.Sprintf("%s.json", customCollectionsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *CustomCollectionsResource) []CustomCollection { return r.Collections })
+}
+
 // Count custom collections
 func (s *CustomCollectionAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *CustomCollectionAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", customCollectionsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual custom collection
 func (s *CustomCollectionAPIOp) Get(collectionID int, options interface{}) (*CustomCollection, error) {
+	return s.GetWithContext(context.Background(), collectionID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CustomCollectionAPIOp) GetWithContext(ctx context.Context, collectionID int, options interface{}) (*CustomCollection, error) {
 	path := fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collectionID)
 	resource := new(CustomCollectionResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Collection, err
 }
 
 // Create a new custom collection
 // See Image for the details of the Image creation for a collection.
 func (s *CustomCollectionAPIOp) Create(collection CustomCollection) (*CustomCollection, error) {
+	return s.CreateWithContext(context.Background(), collection)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CustomCollectionAPIOp) CreateWithContext(ctx context.Context, collection CustomCollection) (*CustomCollection, error) {
 	path := fmt.Sprintf("%s.json", customCollectionsBasePath)
 	wrappedData := CustomCollectionResource{Collection: &collection}
 	resource := new(CustomCollectionResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Collection, err
 }
 
 // Update an existing custom collection
 func (s *CustomCollectionAPIOp) Update(collection CustomCollection) (*CustomCollection, error) {
+	return s.UpdateWithContext(context.Background(), collection)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CustomCollectionAPIOp) UpdateWithContext(ctx context.Context, collection CustomCollection) (*CustomCollection, error) {
 	path := fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collection.ID)
 	wrappedData := CustomCollectionResource{Collection: &collection}
 	resource := new(CustomCollectionResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Collection, err
 }
 
 // Delete an existing custom collection.
 func (s *CustomCollectionAPIOp) Delete(collectionID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collectionID))
+	return s.DeleteWithContext(context.Background(), collectionID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomCollectionAPIOp) DeleteWithContext(ctx context.Context, collectionID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collectionID))
 }
 
 // ListMetafields list metafields for a custom collection
 func (s *CustomCollectionAPIOp) ListMetafields(customCollectionID int, options interface{}) ([]Metafield, error) {
+	return s.ListMetafieldsWithContext(context.Background(), customCollectionID, options)
+}
+
+// ListMetafieldsWithContext is the context-aware variant of ListMetafields.
+func (s *CustomCollectionAPIOp) ListMetafieldsWithContext(ctx context.Context, customCollectionID int, options interface{}) ([]Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
-	return metafieldAPI.List(options)
+	return metafieldAPI.ListWithContext(ctx, options)
 }
 
 // CountMetafields count metafields for a custom collection
 func (s *CustomCollectionAPIOp) CountMetafields(customCollectionID int, options interface{}) (int, error) {
+	return s.CountMetafieldsWithContext(context.Background(), customCollectionID, options)
+}
+
+// CountMetafieldsWithContext is the context-aware variant of CountMetafields.
+func (s *CustomCollectionAPIOp) CountMetafieldsWithContext(ctx context.Context, customCollectionID int, options interface{}) (int, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
-	return metafieldAPI.Count(options)
+	return metafieldAPI.CountWithContext(ctx, options)
 }
 
 // GetMetafield get individual metafield for a custom collection
 func (s *CustomCollectionAPIOp) GetMetafield(customCollectionID int, metafieldID int, options interface{}) (*Metafield, error) {
+	return s.GetMetafieldWithContext(context.Background(), customCollectionID, metafieldID, options)
+}
+
+// GetMetafieldWithContext is the context-aware variant of GetMetafield.
+func (s *CustomCollectionAPIOp) GetMetafieldWithContext(ctx context.Context, customCollectionID int, metafieldID int, options interface{}) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
-	return metafieldAPI.Get(metafieldID, options)
+	return metafieldAPI.GetWithContext(ctx, metafieldID, options)
 }
 
 // CreateMetafield create a new metafield for a custom collection
 func (s *CustomCollectionAPIOp) CreateMetafield(customCollectionID int, metafield Metafield) (*Metafield, error) {
+	return s.CreateMetafieldWithContext(context.Background(), customCollectionID, metafield)
+}
+
+// CreateMetafieldWithContext is the context-aware variant of CreateMetafield.
+func (s *CustomCollectionAPIOp) CreateMetafieldWithContext(ctx context.Context, customCollectionID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
-	return metafieldAPI.Create(metafield)
+	return metafieldAPI.CreateWithContext(ctx, metafield)
 }
 
 // UpdateMetafield update an existing metafield for a custom collection
 func (s *CustomCollectionAPIOp) UpdateMetafield(customCollectionID int, metafield Metafield) (*Metafield, error) {
+	return s.UpdateMetafieldWithContext(context.Background(), customCollectionID, metafield)
+}
+
+// UpdateMetafieldWithContext is the context-aware variant of UpdateMetafield.
+func (s *CustomCollectionAPIOp) UpdateMetafieldWithContext(ctx context.Context, customCollectionID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
-	return metafieldAPI.Update(metafield)
+	return metafieldAPI.UpdateWithContext(ctx, metafield)
 }
 
 // DeleteMetafield delete an existing metafield for a custom collection
 func (s *CustomCollectionAPIOp) DeleteMetafield(customCollectionID int, metafieldID int) error {
+	return s.DeleteMetafieldWithContext(context.Background(), customCollectionID, metafieldID)
+}
+
+// DeleteMetafieldWithContext is the context-aware variant of DeleteMetafield.
+func (s *CustomCollectionAPIOp) DeleteMetafieldWithContext(ctx context.Context, customCollectionID int, metafieldID int) error {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
-	return metafieldAPI.Delete(metafieldID)
+	return metafieldAPI.DeleteWithContext(ctx, metafieldID)
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,14 +16,28 @@ const customersResourceName = "customers"
 // See: https://help.shopify.com/api/reference/customer
 type CustomerAPI interface {
 	List(interface{}) ([]Customer, error)
+	ListWithContext(context.Context, interface{}) ([]Customer, error)
+	ListWithPagination(interface{}) ([]Customer, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Customer, *Pagination, error)
+	ListAll(interface{}) ([]Customer, error)
+	ListAllWithContext(context.Context, interface{}) ([]Customer, error)
+	Stream(context.Context, interface{}) (<-chan Customer, <-chan error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Customer, error)
+	GetWithContext(context.Context, int, interface{}) (*Customer, error)
 	Search(interface{}) ([]Customer, error)
+	SearchWithContext(context.Context, interface{}) ([]Customer, error)
 	Create(Customer) (*Customer, error)
+	CreateWithContext(context.Context, Customer) (*Customer, error)
 	Update(Customer) (*Customer, error)
+	UpdateWithContext(context.Context, Customer) (*Customer, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 	ListOrders(int, interface{}) ([]Order, error)
+	ListOrdersWithContext(context.Context, int, interface{}) ([]Order, error)
 	ListTags(interface{}) ([]string, error)
+	ListTagsWithContext(context.Context, interface{}) ([]string, error)
 
 	// MetafieldsAPI used for Customer resource to communicate with Metafields resource
 	MetafieldsAPI
@@ -85,106 +100,243 @@ type CustomerSearchOptions struct {
 
 // List customers
 func (s *CustomerAPIOp) List(options interface{}) ([]Customer, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Customer, error) {
 	path := fmt.Sprintf("%s.json", customersBasePath)
 	resource := new(CustomersResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Customers, err
 }
 
+// ListWithPagination lists customers and returns the pagination to request the
+// next and previous pages.
+func (s *CustomerAPIOp) ListWithPagination(options interface{}) ([]Customer, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *CustomerAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Customer, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", customersBasePath)
+	return listPage(ctx, s.client, path, options, func(r *CustomersResource) []Customer { return r.Customers })
+}
+
+// ListAll lists all customers, following the pagination from the page
+// requested by options to the last page.
+func (s *CustomerAPIOp) ListAll(options interface{}) ([]Customer, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *CustomerAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Customer, error) {
+	path := fmt.Sprintf("%s.json", customersBasePath)
+	return listAll(ctx, s.client, path, options, func(r *CustomersResource) []Customer { return r.Customers })
+}
+
+// Stream lists all customers like ListAll, but sends them on the returned
+// channel while the following pages are fetched. Up to 250 customers are
+// buffered ahead of the receiver, plus the page being fetched. The error
+// channel receives the error that ended the listing, if any. Both channels
+// are closed when the listing ends.
+func (s *CustomerAPIOp) Stream(ctx context.Context, options interface{}) (<-chan Customer, <-chan error) {
+	customers := make(chan Customer, streamBufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(customers)
+		defer close(errs)
+
+		path := fmt.Sprintf("%s.json", customersBasePath)
+		pager := s.client.NewPager(path, options)
+		for pager.HasNext() {
+			resource := new(CustomersResource)
+			if err := pager.NextWithContext(ctx, resource); err != nil {
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+				errs <- err
+				return
+			}
+			for _, customer := range resource.Customers {
+				select {
+				case customers <- customer:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+
+	return customers, errs
+}
+
 // Count customers
 func (s *CustomerAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *CustomerAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", customersBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get customer
 func (s *CustomerAPIOp) Get(customerID int, options interface{}) (*Customer, error) {
+	return s.GetWithContext(context.Background(), customerID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CustomerAPIOp) GetWithContext(ctx context.Context, customerID int, options interface{}) (*Customer, error) {
 	path := fmt.Sprintf("%s/%v.json", customersBasePath, customerID)
 	resource := new(CustomerResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Customer, err
 }
 
 // Create a new customer
 func (s *CustomerAPIOp) Create(customer Customer) (*Customer, error) {
+	return s.CreateWithContext(context.Background(), customer)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CustomerAPIOp) CreateWithContext(ctx context.Context, customer Customer) (*Customer, error) {
 	path := fmt.Sprintf("%s.json", customersBasePath)
 	wrappedData := CustomerResource{Customer: &customer}
 	resource := new(CustomerResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Customer, err
 }
 
 // Update an existing customer
 func (s *CustomerAPIOp) Update(customer Customer) (*Customer, error) {
+	return s.UpdateWithContext(context.Background(), customer)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CustomerAPIOp) UpdateWithContext(ctx context.Context, customer Customer) (*Customer, error) {
 	path := fmt.Sprintf("%s/%d.json", customersBasePath, customer.ID)
 	wrappedData := CustomerResource{Customer: &customer}
 	resource := new(CustomerResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Customer, err
 }
 
 // Delete an existing customer
 func (s *CustomerAPIOp) Delete(customerID int) error {
+	return s.DeleteWithContext(context.Background(), customerID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomerAPIOp) DeleteWithContext(ctx context.Context, customerID int) error {
 	path := fmt.Sprintf("%s/%d.json", customersBasePath, customerID)
-	return s.client.Delete(path)
+	return s.client.DeleteWithContext(ctx, path)
 }
 
 // Search customers
 func (s *CustomerAPIOp) Search(options interface{}) ([]Customer, error) {
+	return s.SearchWithContext(context.Background(), options)
+}
+
+// SearchWithContext is the context-aware variant of Search.
+func (s *CustomerAPIOp) SearchWithContext(ctx context.Context, options interface{}) ([]Customer, error) {
 	path := fmt.Sprintf("%s/search.json", customersBasePath)
 	resource := new(CustomersResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Customers, err
 }
 
 // ListMetafields list metafields for a customer
 func (s *CustomerAPIOp) ListMetafields(customerID int, options interface{}) ([]Metafield, error) {
+	return s.ListMetafieldsWithContext(context.Background(), customerID, options)
+}
+
+// ListMetafieldsWithContext is the context-aware variant of ListMetafields.
+func (s *CustomerAPIOp) ListMetafieldsWithContext(ctx context.Context, customerID int, options interface{}) ([]Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customersResourceName, resourceID: customerID}
-	return metafieldAPI.List(options)
+	return metafieldAPI.ListWithContext(ctx, options)
 }
 
 // CountMetafields count metafields for a customer
 func (s *CustomerAPIOp) CountMetafields(customerID int, options interface{}) (int, error) {
+	return s.CountMetafieldsWithContext(context.Background(), customerID, options)
+}
+
+// CountMetafieldsWithContext is the context-aware variant of CountMetafields.
+func (s *CustomerAPIOp) CountMetafieldsWithContext(ctx context.Context, customerID int, options interface{}) (int, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customersResourceName, resourceID: customerID}
-	return metafieldAPI.Count(options)
+	return metafieldAPI.CountWithContext(ctx, options)
 }
 
 // GetMetafield get individual metafield for a customer
 func (s *CustomerAPIOp) GetMetafield(customerID int, metafieldID int, options interface{}) (*Metafield, error) {
+	return s.GetMetafieldWithContext(context.Background(), customerID, metafieldID, options)
+}
+
+// GetMetafieldWithContext is the context-aware variant of GetMetafield.
+func (s *CustomerAPIOp) GetMetafieldWithContext(ctx context.Context, customerID int, metafieldID int, options interface{}) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customersResourceName, resourceID: customerID}
-	return metafieldAPI.Get(metafieldID, options)
+	return metafieldAPI.GetWithContext(ctx, metafieldID, options)
 }
 
 // CreateMetafield create a new metafield for a customer
 func (s *CustomerAPIOp) CreateMetafield(customerID int, metafield Metafield) (*Metafield, error) {
+	return s.CreateMetafieldWithContext(context.Background(), customerID, metafield)
+}
+
+// CreateMetafieldWithContext is the context-aware variant of CreateMetafield.
+func (s *CustomerAPIOp) CreateMetafieldWithContext(ctx context.Context, customerID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customersResourceName, resourceID: customerID}
-	return metafieldAPI.Create(metafield)
+	return metafieldAPI.CreateWithContext(ctx, metafield)
 }
 
 // UpdateMetafield update an existing metafield for a customer
 func (s *CustomerAPIOp) UpdateMetafield(customerID int, metafield Metafield) (*Metafield, error) {
+	return s.UpdateMetafieldWithContext(context.Background(), customerID, metafield)
+}
+
+// UpdateMetafieldWithContext is the context-aware variant of UpdateMetafield.
+func (s *CustomerAPIOp) UpdateMetafieldWithContext(ctx context.Context, customerID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customersResourceName, resourceID: customerID}
-	return metafieldAPI.Update(metafield)
+	return metafieldAPI.UpdateWithContext(ctx, metafield)
 }
 
 // DeleteMetafield delete an existing metafield for a customer
 func (s *CustomerAPIOp) DeleteMetafield(customerID int, metafieldID int) error {
+	return s.DeleteMetafieldWithContext(context.Background(), customerID, metafieldID)
+}
+
+// DeleteMetafieldWithContext is the context-aware variant of DeleteMetafield.
+func (s *CustomerAPIOp) DeleteMetafieldWithContext(ctx context.Context, customerID int, metafieldID int) error {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: customersResourceName, resourceID: customerID}
-	return metafieldAPI.Delete(metafieldID)
+	return metafieldAPI.DeleteWithContext(ctx, metafieldID)
 }
 
 // ListOrders retrieves all orders from a customer
 func (s *CustomerAPIOp) ListOrders(customerID int, options interface{}) ([]Order, error) {
+	return s.ListOrdersWithContext(context.Background(), customerID, options)
+}
+
+// ListOrdersWithContext is the context-aware variant of ListOrders.
+func (s *CustomerAPIOp) ListOrdersWithContext(ctx context.Context, customerID int, options interface{}) ([]Order, error) {
 	path := fmt.Sprintf("%s/%d/orders.json", customersBasePath, customerID)
 	resource := new(OrdersResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Orders, err
 }
 
 // ListTags retrieves all unique tags across all customers
 func (s *CustomerAPIOp) ListTags(options interface{}) ([]string, error) {
+	return s.ListTagsWithContext(context.Background(), options)
+}
+
+// ListTagsWithContext is the context-aware variant of ListTags.
+func (s *CustomerAPIOp) ListTagsWithContext(ctx context.Context, options interface{}) ([]string, error) {
 	path := fmt.Sprintf("%s/tags.json", customersBasePath)
 	resource := new(CustomerTagsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Tags, err
 }
@@ -1,6 +1,9 @@
 package goshopify
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 const customerAddressResourceName = "customer-addresses"
 
@@ -9,10 +12,15 @@ const customerAddressResourceName = "customer-addresses"
 // See: https://help.shopify.com/en/api/reference/customers/customer_address
 type CustomerAddressAPI interface {
 	List(int, interface{}) ([]CustomerAddress, error)
+	ListWithContext(context.Context, int, interface{}) ([]CustomerAddress, error)
 	Get(int, int, interface{}) (*CustomerAddress, error)
+	GetWithContext(context.Context, int, int, interface{}) (*CustomerAddress, error)
 	Create(int, CustomerAddress) (*CustomerAddress, error)
+	CreateWithContext(context.Context, int, CustomerAddress) (*CustomerAddress, error)
 	Update(int, CustomerAddress) (*CustomerAddress, error)
+	UpdateWithContext(context.Context, int, CustomerAddress) (*CustomerAddress, error)
 	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
 }
 
 // CustomerAddressAPIOp handles communication with the customer address related methods of
@@ -54,39 +62,64 @@ type CustomerAddressesResource struct {
 
 // List addresses
 func (s *CustomerAddressAPIOp) List(customerID int, options interface{}) ([]CustomerAddress, error) {
+	return s.ListWithContext(context.Background(), customerID, options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerAddressAPIOp) ListWithContext(ctx context.Context, customerID int, options interface{}) ([]CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerID)
 	resource := new(CustomerAddressesResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Addresses, err
 }
 
 // Get address
 func (s *CustomerAddressAPIOp) Get(customerID, addressID int, options interface{}) (*CustomerAddress, error) {
+	return s.GetWithContext(context.Background(), customerID, addressID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CustomerAddressAPIOp) GetWithContext(ctx context.Context, customerID, addressID int, options interface{}) (*CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID)
 	resource := new(CustomerAddressResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Address, err
 }
 
 // Create a new address for given customer
 func (s *CustomerAddressAPIOp) Create(customerID int, address CustomerAddress) (*CustomerAddress, error) {
+	return s.CreateWithContext(context.Background(), customerID, address)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CustomerAddressAPIOp) CreateWithContext(ctx context.Context, customerID int, address CustomerAddress) (*CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerID)
 	wrappedData := CustomerAddressResource{Address: &address}
 	resource := new(CustomerAddressResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Address, err
 }
 
 // Update a address for given customer
 func (s *CustomerAddressAPIOp) Update(customerID int, address CustomerAddress) (*CustomerAddress, error) {
+	return s.UpdateWithContext(context.Background(), customerID, address)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CustomerAddressAPIOp) UpdateWithContext(ctx context.Context, customerID int, address CustomerAddress) (*CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, address.ID)
 	wrappedData := CustomerAddressResource{Address: &address}
 	resource := new(CustomerAddressResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Address, err
 }
 
 // Delete an existing address
 func (s *CustomerAddressAPIOp) Delete(customerID, addressID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID))
+	return s.DeleteWithContext(context.Background(), customerID, addressID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomerAddressAPIOp) DeleteWithContext(ctx context.Context, customerID, addressID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID))
 }
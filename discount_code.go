@@ -0,0 +1,287 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const discountCodesBasePath = "admin/discount_codes"
+
+// Statuses of a discount code creation job.
+const (
+	DiscountCodeCreationQueued    = "queued"
+	DiscountCodeCreationRunning   = "running"
+	DiscountCodeCreationCompleted = "completed"
+)
+
+// defaultBatchPollInterval is the interval WaitForBatch polls a job with if
+// no interval is given.
+const defaultBatchPollInterval = time.Second
+
+// DiscountCodeAPI is an interface for interfacing with the discount codes
+// endpoints of the Shopify API. Discount codes belong to a price rule.
+// See: https://help.shopify.com/en/api/reference/discounts/discountcode
+type DiscountCodeAPI interface {
+	List(int, interface{}) ([]PriceRuleDiscountCode, error)
+	ListWithContext(context.Context, int, interface{}) ([]PriceRuleDiscountCode, error)
+	ListWithPagination(int, interface{}) ([]PriceRuleDiscountCode, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, int, interface{}) ([]PriceRuleDiscountCode, *Pagination, error)
+	ListAll(int, interface{}) ([]PriceRuleDiscountCode, error)
+	ListAllWithContext(context.Context, int, interface{}) ([]PriceRuleDiscountCode, error)
+	Get(int, int, interface{}) (*PriceRuleDiscountCode, error)
+	GetWithContext(context.Context, int, int, interface{}) (*PriceRuleDiscountCode, error)
+	Create(int, PriceRuleDiscountCode) (*PriceRuleDiscountCode, error)
+	CreateWithContext(context.Context, int, PriceRuleDiscountCode) (*PriceRuleDiscountCode, error)
+	Update(int, PriceRuleDiscountCode) (*PriceRuleDiscountCode, error)
+	UpdateWithContext(context.Context, int, PriceRuleDiscountCode) (*PriceRuleDiscountCode, error)
+	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
+	Lookup(string) (*PriceRuleDiscountCode, error)
+	LookupWithContext(context.Context, string) (*PriceRuleDiscountCode, error)
+	CreateBatch(int, []PriceRuleDiscountCode) (*DiscountCodeCreation, error)
+	CreateBatchWithContext(context.Context, int, []PriceRuleDiscountCode) (*DiscountCodeCreation, error)
+	GetBatch(int, int) (*DiscountCodeCreation, error)
+	GetBatchWithContext(context.Context, int, int) (*DiscountCodeCreation, error)
+	ListBatchCodes(int, int) ([]PriceRuleDiscountCode, error)
+	ListBatchCodesWithContext(context.Context, int, int) ([]PriceRuleDiscountCode, error)
+	WaitForBatch(int, int, time.Duration) (*DiscountCodeCreation, error)
+	WaitForBatchWithContext(context.Context, int, int, time.Duration) (*DiscountCodeCreation, error)
+}
+
+// DiscountCodeAPIOp handles communication with the discount code related
+// methods of the Shopify API.
+type DiscountCodeAPIOp struct {
+	client *Client
+}
+
+// PriceRuleDiscountCode represents a Shopify discount code of a price rule.
+// It is not to be confused with the DiscountCode applied to an order.
+type PriceRuleDiscountCode struct {
+	ID          int                 `json:"id,omitempty"`
+	PriceRuleID int                 `json:"price_rule_id,omitempty"`
+	Code        string              `json:"code,omitempty"`
+	UsageCount  int                 `json:"usage_count,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+	Errors      map[string][]string `json:"errors,omitempty"`
+}
+
+// DiscountCodeCreation represents an asynchronous job creating a batch of
+// discount codes
+type DiscountCodeCreation struct {
+	ID            int        `json:"id,omitempty"`
+	PriceRuleID   int        `json:"price_rule_id,omitempty"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	Status        string     `json:"status,omitempty"`
+	CodesCount    int        `json:"codes_count,omitempty"`
+	ImportedCount int        `json:"imported_count,omitempty"`
+	FailedCount   int        `json:"failed_count,omitempty"`
+}
+
+// PriceRuleDiscountCodeResource represents the result from the
+// price_rules/X/discount_codes/Y.json endpoint
+type PriceRuleDiscountCodeResource struct {
+	DiscountCode *PriceRuleDiscountCode `json:"discount_code"`
+}
+
+// PriceRuleDiscountCodesResource represents the result from the
+// price_rules/X/discount_codes.json endpoint
+type PriceRuleDiscountCodesResource struct {
+	DiscountCodes []PriceRuleDiscountCode `json:"discount_codes"`
+}
+
+// DiscountCodeCreationResource represents the result from the
+// price_rules/X/batch.json and price_rules/X/batch/Y.json endpoints
+type DiscountCodeCreationResource struct {
+	DiscountCodeCreation *DiscountCodeCreation `json:"discount_code_creation"`
+}
+
+// discountCodeLookupOptions are the query options of the
+// discount_codes/lookup.json endpoint
+type discountCodeLookupOptions struct {
+	Code string `url:"code"`
+}
+
+// List discount codes of a price rule
+func (s *DiscountCodeAPIOp) List(priceRuleID int, options interface{}) ([]PriceRuleDiscountCode, error) {
+	return s.ListWithContext(context.Background(), priceRuleID, options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *DiscountCodeAPIOp) ListWithContext(ctx context.Context, priceRuleID int, options interface{}) ([]PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/%d/discount_codes.json", priceRulesBasePath, priceRuleID)
+	resource := new(PriceRuleDiscountCodesResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.DiscountCodes, err
+}
+
+// ListWithPagination lists discount codes of a price rule and returns the pagination to request the
+// next and previous pages.
+func (s *DiscountCodeAPIOp) ListWithPagination(priceRuleID int, options interface{}) ([]PriceRuleDiscountCode, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), priceRuleID, options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *DiscountCodeAPIOp) ListWithPaginationWithContext(ctx context.Context, priceRuleID int, options interface{}) ([]PriceRuleDiscountCode, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/discount_codes.json", priceRulesBasePath, priceRuleID)
+	return listPage(ctx, s.client, path, options, func(r *PriceRuleDiscountCodesResource) []PriceRuleDiscountCode { return r.DiscountCodes })
+}
+
+// ListAll lists all discount codes of a price rule, following the pagination from the page
+// requested by options to the last page.
+func (s *DiscountCodeAPIOp) ListAll(priceRuleID int, options interface{}) ([]PriceRuleDiscountCode, error) {
+	return s.ListAllWithContext(context.Background(), priceRuleID, options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *DiscountCodeAPIOp) ListAllWithContext(ctx context.Context, priceRuleID int, options interface{}) ([]PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/%d/discount_codes.json", priceRulesBasePath, priceRuleID)
+	return listAll(ctx, s.client, path, options, func(r *PriceRuleDiscountCodesResource) []PriceRuleDiscountCode { return r.DiscountCodes })
+}
+
+// Get individual discount code of a price rule
+func (s *DiscountCodeAPIOp) Get(priceRuleID int, discountCodeID int, options interface{}) (*PriceRuleDiscountCode, error) {
+	return s.GetWithContext(context.Background(), priceRuleID, discountCodeID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *DiscountCodeAPIOp) GetWithContext(ctx context.Context, priceRuleID int, discountCodeID int, options interface{}) (*PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/%d/discount_codes/%d.json", priceRulesBasePath, priceRuleID, discountCodeID)
+	resource := new(PriceRuleDiscountCodeResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.DiscountCode, err
+}
+
+// Create a new discount code for a price rule
+func (s *DiscountCodeAPIOp) Create(priceRuleID int, discountCode PriceRuleDiscountCode) (*PriceRuleDiscountCode, error) {
+	return s.CreateWithContext(context.Background(), priceRuleID, discountCode)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *DiscountCodeAPIOp) CreateWithContext(ctx context.Context, priceRuleID int, discountCode PriceRuleDiscountCode) (*PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/%d/discount_codes.json", priceRulesBasePath, priceRuleID)
+	wrappedData := PriceRuleDiscountCodeResource{DiscountCode: &discountCode}
+	resource := new(PriceRuleDiscountCodeResource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
+	return resource.DiscountCode, err
+}
+
+// Update an existing discount code of a price rule
+func (s *DiscountCodeAPIOp) Update(priceRuleID int, discountCode PriceRuleDiscountCode) (*PriceRuleDiscountCode, error) {
+	return s.UpdateWithContext(context.Background(), priceRuleID, discountCode)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *DiscountCodeAPIOp) UpdateWithContext(ctx context.Context, priceRuleID int, discountCode PriceRuleDiscountCode) (*PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/%d/discount_codes/%d.json", priceRulesBasePath, priceRuleID, discountCode.ID)
+	wrappedData := PriceRuleDiscountCodeResource{DiscountCode: &discountCode}
+	resource := new(PriceRuleDiscountCodeResource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
+	return resource.DiscountCode, err
+}
+
+// Delete an existing discount code of a price rule
+func (s *DiscountCodeAPIOp) Delete(priceRuleID int, discountCodeID int) error {
+	return s.DeleteWithContext(context.Background(), priceRuleID, discountCodeID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *DiscountCodeAPIOp) DeleteWithContext(ctx context.Context, priceRuleID int, discountCodeID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d/discount_codes/%d.json", priceRulesBasePath, priceRuleID, discountCodeID))
+}
+
+// Lookup finds a discount code by its code, regardless of its price rule.
+// Shopify answers with a redirect to the discount code, which is followed.
+func (s *DiscountCodeAPIOp) Lookup(code string) (*PriceRuleDiscountCode, error) {
+	return s.LookupWithContext(context.Background(), code)
+}
+
+// LookupWithContext is the context-aware variant of Lookup.
+func (s *DiscountCodeAPIOp) LookupWithContext(ctx context.Context, code string) (*PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/lookup.json", discountCodesBasePath)
+	resource := new(PriceRuleDiscountCodeResource)
+	err := s.client.GetWithContext(ctx, path, resource, discountCodeLookupOptions{Code: code})
+	return resource.DiscountCode, err
+}
+
+// CreateBatch starts a job creating up to 100 discount codes for a price
+// rule. Use WaitForBatch to wait for the job to complete.
+func (s *DiscountCodeAPIOp) CreateBatch(priceRuleID int, discountCodes []PriceRuleDiscountCode) (*DiscountCodeCreation, error) {
+	return s.CreateBatchWithContext(context.Background(), priceRuleID, discountCodes)
+}
+
+// CreateBatchWithContext is the context-aware variant of CreateBatch.
+func (s *DiscountCodeAPIOp) CreateBatchWithContext(ctx context.Context, priceRuleID int, discountCodes []PriceRuleDiscountCode) (*DiscountCodeCreation, error) {
+	path := fmt.Sprintf("%s/%d/batch.json", priceRulesBasePath, priceRuleID)
+	wrappedData := PriceRuleDiscountCodesResource{DiscountCodes: discountCodes}
+	resource := new(DiscountCodeCreationResource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
+	return resource.DiscountCodeCreation, err
+}
+
+// GetBatch gets the status of a discount code creation job
+func (s *DiscountCodeAPIOp) GetBatch(priceRuleID int, batchID int) (*DiscountCodeCreation, error) {
+	return s.GetBatchWithContext(context.Background(), priceRuleID, batchID)
+}
+
+// GetBatchWithContext is the context-aware variant of GetBatch.
+func (s *DiscountCodeAPIOp) GetBatchWithContext(ctx context.Context, priceRuleID int, batchID int) (*DiscountCodeCreation, error) {
+	path := fmt.Sprintf("%s/%d/batch/%d.json", priceRulesBasePath, priceRuleID, batchID)
+	resource := new(DiscountCodeCreationResource)
+	err := s.client.GetWithContext(ctx, path, resource, nil)
+	return resource.DiscountCodeCreation, err
+}
+
+// ListBatchCodes lists the discount codes of a discount code creation job.
+// Codes that could not be created carry their Errors.
+func (s *DiscountCodeAPIOp) ListBatchCodes(priceRuleID int, batchID int) ([]PriceRuleDiscountCode, error) {
+	return s.ListBatchCodesWithContext(context.Background(), priceRuleID, batchID)
+}
+
+// ListBatchCodesWithContext is the context-aware variant of ListBatchCodes.
+func (s *DiscountCodeAPIOp) ListBatchCodesWithContext(ctx context.Context, priceRuleID int, batchID int) ([]PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/%d/batch/%d/discount_codes.json", priceRulesBasePath, priceRuleID, batchID)
+	resource := new(PriceRuleDiscountCodesResource)
+	err := s.client.GetWithContext(ctx, path, resource, nil)
+	return resource.DiscountCodes, err
+}
+
+// WaitForBatch polls a discount code creation job every interval until it is
+// completed. An interval of zero polls every second. On error, the job as it
+// was last polled is returned along with the error.
+func (s *DiscountCodeAPIOp) WaitForBatch(priceRuleID int, batchID int, interval time.Duration) (*DiscountCodeCreation, error) {
+	return s.WaitForBatchWithContext(context.Background(), priceRuleID, batchID, interval)
+}
+
+// WaitForBatchWithContext is the context-aware variant of WaitForBatch. It
+// stops waiting when ctx is done.
+func (s *DiscountCodeAPIOp) WaitForBatchWithContext(ctx context.Context, priceRuleID int, batchID int, interval time.Duration) (*DiscountCodeCreation, error) {
+	if interval <= 0 {
+		interval = defaultBatchPollInterval
+	}
+
+	var last *DiscountCodeCreation
+	for {
+		batch, err := s.GetBatchWithContext(ctx, priceRuleID, batchID)
+		if ctx.Err() != nil {
+			return last, ctx.Err()
+		}
+		if err != nil {
+			return last, err
+		}
+		if batch == nil {
+			return last, fmt.Errorf("discount code creation job %d of price rule %d is missing in the response", batchID, priceRuleID)
+		}
+		last = batch
+		if batch.Status == DiscountCodeCreationCompleted {
+			return batch, nil
+		}
+		if err := sleepContext(ctx, interval); err != nil {
+			return last, err
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package goshopify
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func discountCodeTests(t *testing.T, discountCode PriceRuleDiscountCode) {
+	expected := PriceRuleDiscountCode{
+		ID:          507328175,
+		PriceRuleID: 507328175,
+		Code:        "SPRINGSALE10",
+		UsageCount:  3,
+	}
+	discountCode.CreatedAt = nil
+	discountCode.UpdatedAt = nil
+	if !reflect.DeepEqual(discountCode, expected) {
+		t.Errorf("DiscountCode returned %+v, expected %+v", discountCode, expected)
+	}
+}
+
+func TestDiscountCodeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes.json",
+		httpmock.NewStringResponder(200, `{"discount_codes": [{"id":1,"code":"A"},{"id":2,"code":"B"}]}`))
+
+	discountCodes, err := client.DiscountCode.List(507328175, nil)
+	if err != nil {
+		t.Errorf("DiscountCode.List returned error: %v", err)
+	}
+
+	expected := []PriceRuleDiscountCode{{ID: 1, Code: "A"}, {ID: 2, Code: "B"}}
+	if !reflect.DeepEqual(discountCodes, expected) {
+		t.Errorf("DiscountCode.List returned %+v, expected %+v", discountCodes, expected)
+	}
+}
+
+func TestDiscountCodeGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code.json")))
+
+	discountCode, err := client.DiscountCode.Get(507328175, 507328175, nil)
+	if err != nil {
+		t.Fatalf("DiscountCode.Get returned error: %v", err)
+	}
+
+	discountCodeTests(t, *discountCode)
+}
+
+func TestDiscountCodeCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes.json",
+		httpmock.NewBytesResponder(201, loadFixture("discount_code.json")))
+
+	discountCode, err := client.DiscountCode.Create(507328175, PriceRuleDiscountCode{Code: "SPRINGSALE10"})
+	if err != nil {
+		t.Fatalf("DiscountCode.Create returned error: %v", err)
+	}
+
+	discountCodeTests(t, *discountCode)
+}
+
+func TestDiscountCodeUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code.json")))
+
+	discountCode, err := client.DiscountCode.Update(507328175, PriceRuleDiscountCode{ID: 507328175, Code: "SPRINGSALE10"})
+	if err != nil {
+		t.Fatalf("DiscountCode.Update returned error: %v", err)
+	}
+
+	discountCodeTests(t, *discountCode)
+}
+
+func TestDiscountCodeDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		httpmock.NewStringResponder(204, ""))
+
+	err := client.DiscountCode.Delete(507328175, 507328175)
+	if err != nil {
+		t.Errorf("DiscountCode.Delete returned error: %v", err)
+	}
+}
+
+func TestDiscountCodeLookup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"code": "SPRINGSALE10"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		"https://fooshop.myshopify.com/admin/discount_codes/lookup.json",
+		params,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(303, "")
+			resp.Header.Set("Location", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json")
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Shopify-Access-Token") != "abcd" {
+				t.Errorf("DiscountCode.Lookup did not forward the access token to the redirect")
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("discount_code.json")), nil
+		})
+
+	discountCode, err := client.DiscountCode.Lookup("SPRINGSALE10")
+	if err != nil {
+		t.Fatalf("DiscountCode.Lookup returned error: %v", err)
+	}
+
+	discountCodeTests(t, *discountCode)
+}
+
+func TestDiscountCodeCreateBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch.json",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"discount_codes":[{"code":"SUMMER1"},{"code":"SUMMER2"},{"code":"SUMMER2"}]}`
+			if string(body) != expected {
+				t.Errorf("DiscountCode.CreateBatch sent body %s, expected %s", body, expected)
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("discount_code_creation.json")), nil
+		})
+
+	codes := []PriceRuleDiscountCode{{Code: "SUMMER1"}, {Code: "SUMMER2"}, {Code: "SUMMER2"}}
+	batch, err := client.DiscountCode.CreateBatch(507328175, codes)
+	if err != nil {
+		t.Fatalf("DiscountCode.CreateBatch returned error: %v", err)
+	}
+
+	if batch.ID != 989355119 || batch.Status != DiscountCodeCreationQueued || batch.CodesCount != 3 {
+		t.Errorf("DiscountCode.CreateBatch returned %+v, expected a queued batch of 3 codes", batch)
+	}
+}
+
+func TestDiscountCodeWaitForBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	statuses := []string{"queued", "running", "completed"}
+	calls := 0
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json",
+		func(req *http.Request) (*http.Response, error) {
+			status := statuses[calls]
+			calls++
+			return httpmock.NewStringResponse(200, `{"discount_code_creation":{"id":989355119,"status":"`+status+`","imported_count":2,"failed_count":1}}`), nil
+		})
+
+	batch, err := client.DiscountCode.WaitForBatch(507328175, 989355119, time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscountCode.WaitForBatch returned error: %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("DiscountCode.WaitForBatch polled %d times, expected 3", calls)
+	}
+	expected := DiscountCodeCreation{ID: 989355119, Status: "completed", ImportedCount: 2, FailedCount: 1}
+	if !reflect.DeepEqual(*batch, expected) {
+		t.Errorf("DiscountCode.WaitForBatch returned %+v, expected %+v", batch, expected)
+	}
+}
+
+func TestDiscountCodeWaitForBatchCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json",
+		httpmock.NewStringResponder(200, `{"discount_code_creation":{"id":989355119,"status":"running"}}`))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	batch, err := client.DiscountCode.WaitForBatchWithContext(ctx, 507328175, 989355119, time.Millisecond)
+	if err != context.DeadlineExceeded {
+		t.Errorf("DiscountCode.WaitForBatchWithContext returned error %v, expected %v", err, context.DeadlineExceeded)
+	}
+	if batch == nil || batch.Status != DiscountCodeCreationRunning {
+		t.Errorf("DiscountCode.WaitForBatchWithContext returned %+v, expected the last polled status", batch)
+	}
+}
+
+func TestDiscountCodeWaitForBatchMissing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json",
+		httpmock.NewStringResponder(200, `{}`))
+
+	batch, err := client.DiscountCode.WaitForBatch(507328175, 989355119, time.Millisecond)
+	if err == nil {
+		t.Errorf("DiscountCode.WaitForBatch of a missing job returned nil error")
+	}
+	if batch != nil {
+		t.Errorf("DiscountCode.WaitForBatch of a missing job returned %+v, expected nil", batch)
+	}
+}
+
+func TestDiscountCodeListBatchCodes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119/discount_codes.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_batch_codes.json")))
+
+	codes, err := client.DiscountCode.ListBatchCodes(507328175, 989355119)
+	if err != nil {
+		t.Fatalf("DiscountCode.ListBatchCodes returned error: %v", err)
+	}
+
+	if len(codes) != 3 {
+		t.Fatalf("DiscountCode.ListBatchCodes returned %d codes, expected 3", len(codes))
+	}
+	expectedErrors := map[string][]string{"code": {"must be unique"}}
+	if !reflect.DeepEqual(codes[2].Errors, expectedErrors) {
+		t.Errorf("DiscountCode.ListBatchCodes returned errors %v, expected %v", codes[2].Errors, expectedErrors)
+	}
+	if len(codes[0].Errors) != 0 {
+		t.Errorf("DiscountCode.ListBatchCodes returned errors %v for a created code, expected none", codes[0].Errors)
+	}
+}
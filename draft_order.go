@@ -0,0 +1,247 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const draftOrdersBasePath = "admin/draft_orders"
+
+// DraftOrderAPI is an interface for interfacing with the draft orders endpoints
+// of the Shopify API.
+// See: https://help.shopify.com/api/reference/orders/draftorder
+type DraftOrderAPI interface {
+	List(interface{}) ([]DraftOrder, error)
+	ListWithContext(context.Context, interface{}) ([]DraftOrder, error)
+	ListWithPagination(interface{}) ([]DraftOrder, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]DraftOrder, *Pagination, error)
+	ListAll(interface{}) ([]DraftOrder, error)
+	ListAllWithContext(context.Context, interface{}) ([]DraftOrder, error)
+	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
+	Get(int, interface{}) (*DraftOrder, error)
+	GetWithContext(context.Context, int, interface{}) (*DraftOrder, error)
+	Create(DraftOrder) (*DraftOrder, error)
+	CreateWithContext(context.Context, DraftOrder) (*DraftOrder, error)
+	Update(DraftOrder) (*DraftOrder, error)
+	UpdateWithContext(context.Context, DraftOrder) (*DraftOrder, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	SendInvoice(int, DraftOrderInvoice) (*DraftOrderInvoice, error)
+	SendInvoiceWithContext(context.Context, int, DraftOrderInvoice) (*DraftOrderInvoice, error)
+	Complete(int, bool) (*DraftOrder, error)
+	CompleteWithContext(context.Context, int, bool) (*DraftOrder, error)
+}
+
+// DraftOrderAPIOp handles communication with the draft order related methods
+// of the Shopify API.
+type DraftOrderAPIOp struct {
+	client *Client
+}
+
+// DraftOrderListOptions a struct for all available draft order list options.
+// See: https://help.shopify.com/api/reference/orders/draftorder#index
+type DraftOrderListOptions struct {
+	IDs          []int      `url:"ids,omitempty,comma"`
+	Limit        int        `url:"limit,omitempty"`
+	Page         int        `url:"page,omitempty"`
+	SinceID      int        `url:"since_id,omitempty"`
+	UpdatedAtMin *time.Time `url:"updated_at_min,omitempty"`
+	UpdatedAtMax *time.Time `url:"updated_at_max,omitempty"`
+	Status       string     `url:"status,omitempty"`
+	Fields       string     `url:"fields,omitempty"`
+}
+
+// DraftOrder represents a Shopify draft order
+type DraftOrder struct {
+	ID                        int              `json:"id,omitempty"`
+	OrderID                   int              `json:"order_id,omitempty"`
+	Name                      string           `json:"name,omitempty"`
+	Customer                  *Customer        `json:"customer,omitempty"`
+	UseCustomerDefaultAddress *bool            `json:"use_customer_default_address,omitempty"`
+	ShippingAddress           *Address         `json:"shipping_address,omitempty"`
+	BillingAddress            *Address         `json:"billing_address,omitempty"`
+	Note                      string           `json:"note,omitempty"`
+	NoteAttributes            []NoteAttribute  `json:"note_attributes,omitempty"`
+	Email                     string           `json:"email,omitempty"`
+	Currency                  string           `json:"currency,omitempty"`
+	InvoiceSentAt             *time.Time       `json:"invoice_sent_at,omitempty"`
+	InvoiceURL                string           `json:"invoice_url,omitempty"`
+	LineItems                 []LineItem       `json:"line_items,omitempty"`
+	ShippingLine              *ShippingLine    `json:"shipping_line,omitempty"`
+	Tags                      string           `json:"tags,omitempty"`
+	TaxExempt                 *bool            `json:"tax_exempt,omitempty"`
+	TaxLines                  []TaxLine        `json:"tax_lines,omitempty"`
+	AppliedDiscount           *AppliedDiscount `json:"applied_discount,omitempty"`
+	TaxesIncluded             *bool            `json:"taxes_included,omitempty"`
+	TotalTax                  *decimal.Decimal `json:"total_tax,omitempty"`
+	SubtotalPrice             *decimal.Decimal `json:"subtotal_price,omitempty"`
+	TotalPrice                *decimal.Decimal `json:"total_price,omitempty"`
+	CompletedAt               *time.Time       `json:"completed_at,omitempty"`
+	CreatedAt                 *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt                 *time.Time       `json:"updated_at,omitempty"`
+	Status                    string           `json:"status,omitempty"`
+}
+
+// DraftOrderInvoice is the email sent to the customer of a draft order. Empty
+// fields are filled in by Shopify from the shop's invoice template.
+type DraftOrderInvoice struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+	Bcc           []string `json:"bcc,omitempty"`
+}
+
+// DraftOrderResource represents the result from the draft_orders/X.json endpoint
+type DraftOrderResource struct {
+	DraftOrder *DraftOrder `json:"draft_order"`
+}
+
+// DraftOrdersResource represents the result from the draft_orders.json endpoint
+type DraftOrdersResource struct {
+	DraftOrders []DraftOrder `json:"draft_orders"`
+}
+
+// DraftOrderInvoiceResource represents the result from the
+// draft_orders/X/send_invoice.json endpoint
+type DraftOrderInvoiceResource struct {
+	DraftOrderInvoice *DraftOrderInvoice `json:"draft_order_invoice"`
+}
+
+// draftOrderCompleteOptions are the query options of the
+// draft_orders/X/complete.json endpoint
+type draftOrderCompleteOptions struct {
+	PaymentPending bool `url:"payment_pending,omitempty"`
+}
+
+// List draft orders
+func (s *DraftOrderAPIOp) List(options interface{}) ([]DraftOrder, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *DraftOrderAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]DraftOrder, error) {
+	path := fmt.Sprintf("%s.json", draftOrdersBasePath)
+	resource := new(DraftOrdersResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.DraftOrders, err
+}
+
+// ListWithPagination lists draft orders and returns the pagination to request the
+// next and previous pages.
+func (s *DraftOrderAPIOp) ListWithPagination(options interface{}) ([]DraftOrder, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *DraftOrderAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]DraftOrder, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", draftOrdersBasePath)
+	return listPage(ctx, s.client, path, options, func(r *DraftOrdersResource) []DraftOrder { return r.DraftOrders })
+}
+
+// ListAll lists all draft orders, following the pagination from the page
+// requested by options to the last page.
+func (s *DraftOrderAPIOp) ListAll(options interface{}) ([]DraftOrder, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *DraftOrderAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]DraftOrder, error) {
+	path := fmt.Sprintf("%s.json", draftOrdersBasePath)
+	return listAll(ctx, s.client, path, options, func(r *DraftOrdersResource) []DraftOrder { return r.DraftOrders })
+}
+
+// Count draft orders
+func (s *DraftOrderAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *DraftOrderAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", draftOrdersBasePath)
+	return s.client.CountWithContext(ctx, path, options)
+}
+
+// Get individual draft order
+func (s *DraftOrderAPIOp) Get(draftOrderID int, options interface{}) (*DraftOrder, error) {
+	return s.GetWithContext(context.Background(), draftOrderID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *DraftOrderAPIOp) GetWithContext(ctx context.Context, draftOrderID int, options interface{}) (*DraftOrder, error) {
+	path := fmt.Sprintf("%s/%d.json", draftOrdersBasePath, draftOrderID)
+	resource := new(DraftOrderResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.DraftOrder, err
+}
+
+// Create draft order
+func (s *DraftOrderAPIOp) Create(draftOrder DraftOrder) (*DraftOrder, error) {
+	return s.CreateWithContext(context.Background(), draftOrder)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *DraftOrderAPIOp) CreateWithContext(ctx context.Context, draftOrder DraftOrder) (*DraftOrder, error) {
+	path := fmt.Sprintf("%s.json", draftOrdersBasePath)
+	wrappedData := DraftOrderResource{DraftOrder: &draftOrder}
+	resource := new(DraftOrderResource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
+	return resource.DraftOrder, err
+}
+
+// Update draft order
+func (s *DraftOrderAPIOp) Update(draftOrder DraftOrder) (*DraftOrder, error) {
+	return s.UpdateWithContext(context.Background(), draftOrder)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *DraftOrderAPIOp) UpdateWithContext(ctx context.Context, draftOrder DraftOrder) (*DraftOrder, error) {
+	path := fmt.Sprintf("%s/%d.json", draftOrdersBasePath, draftOrder.ID)
+	wrappedData := DraftOrderResource{DraftOrder: &draftOrder}
+	resource := new(DraftOrderResource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
+	return resource.DraftOrder, err
+}
+
+// Delete draft order
+func (s *DraftOrderAPIOp) Delete(draftOrderID int) error {
+	return s.DeleteWithContext(context.Background(), draftOrderID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *DraftOrderAPIOp) DeleteWithContext(ctx context.Context, draftOrderID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", draftOrdersBasePath, draftOrderID))
+}
+
+// SendInvoice sends an invoice for the draft order to the customer
+func (s *DraftOrderAPIOp) SendInvoice(draftOrderID int, invoice DraftOrderInvoice) (*DraftOrderInvoice, error) {
+	return s.SendInvoiceWithContext(context.Background(), draftOrderID, invoice)
+}
+
+// SendInvoiceWithContext is the context-aware variant of SendInvoice.
+func (s *DraftOrderAPIOp) SendInvoiceWithContext(ctx context.Context, draftOrderID int, invoice DraftOrderInvoice) (*DraftOrderInvoice, error) {
+	path := fmt.Sprintf("%s/%d/send_invoice.json", draftOrdersBasePath, draftOrderID)
+	wrappedData := DraftOrderInvoiceResource{DraftOrderInvoice: &invoice}
+	resource := new(DraftOrderInvoiceResource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
+	return resource.DraftOrderInvoice, err
+}
+
+// Complete turns the draft order into an order. If paymentPending is true the
+// order is marked as pending, otherwise it is marked as paid.
+func (s *DraftOrderAPIOp) Complete(draftOrderID int, paymentPending bool) (*DraftOrder, error) {
+	return s.CompleteWithContext(context.Background(), draftOrderID, paymentPending)
+}
+
+// CompleteWithContext is the context-aware variant of Complete.
+func (s *DraftOrderAPIOp) CompleteWithContext(ctx context.Context, draftOrderID int, paymentPending bool) (*DraftOrder, error) {
+	path := fmt.Sprintf("%s/%d/complete.json", draftOrdersBasePath, draftOrderID)
+	options := draftOrderCompleteOptions{PaymentPending: paymentPending}
+	resource := new(DraftOrderResource)
+	err := s.client.CreateAndDoWithContext(ctx, "PUT", path, nil, options, resource)
+	return resource.DraftOrder, err
+}
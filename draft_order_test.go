@@ -0,0 +1,256 @@
+package goshopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func draftOrderTests(t *testing.T, draftOrder DraftOrder) {
+	// Check that dates are parsed
+	d := time.Date(2019, time.January, 24, 16, 18, 42, 0, time.UTC)
+	if !d.Equal(*draftOrder.CreatedAt) {
+		t.Errorf("DraftOrder.CreatedAt returned %+v, expected %+v", draftOrder.CreatedAt, d)
+	}
+
+	// Check prices
+	p := decimal.NewFromFloat(189.54)
+	if !p.Equals(*draftOrder.TotalPrice) {
+		t.Errorf("DraftOrder.TotalPrice returned %+v, expected %+v", draftOrder.TotalPrice, p)
+	}
+
+	// Check the discounts of the draft order and of its line items
+	if draftOrder.AppliedDiscount == nil || draftOrder.AppliedDiscount.ValueType != "fixed_amount" {
+		t.Errorf("DraftOrder.AppliedDiscount returned %+v, expected a fixed_amount discount", draftOrder.AppliedDiscount)
+	}
+	if len(draftOrder.LineItems) != 1 {
+		t.Fatalf("DraftOrder.LineItems returned %d items, expected 1", len(draftOrder.LineItems))
+	}
+	discount := draftOrder.LineItems[0].AppliedDiscount
+	a := decimal.NewFromFloat(19.90)
+	if discount == nil || !a.Equals(*discount.Amount) {
+		t.Errorf("LineItem.AppliedDiscount returned %+v, expected amount %v", discount, a)
+	}
+
+	if draftOrder.Customer == nil || draftOrder.Customer.ID != 207119551 {
+		t.Errorf("DraftOrder.Customer returned %+v, expected id 207119551", draftOrder.Customer)
+	}
+}
+
+func TestDraftOrderList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/draft_orders.json",
+		httpmock.NewStringResponder(200, `{"draft_orders": [{"id":1},{"id":2}]}`))
+
+	draftOrders, err := client.DraftOrder.List(nil)
+	if err != nil {
+		t.Errorf("DraftOrder.List returned error: %v", err)
+	}
+
+	expected := []DraftOrder{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(draftOrders, expected) {
+		t.Errorf("DraftOrder.List returned %+v, expected %+v", draftOrders, expected)
+	}
+}
+
+func TestDraftOrderCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/draft_orders/count.json",
+		httpmock.NewStringResponder(200, `{"count": 7}`))
+
+	params := map[string]string{"status": "open"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		"https://fooshop.myshopify.com/admin/draft_orders/count.json",
+		params,
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.DraftOrder.Count(nil)
+	if err != nil {
+		t.Errorf("DraftOrder.Count returned error: %v", err)
+	}
+
+	expected := 7
+	if cnt != expected {
+		t.Errorf("DraftOrder.Count returned %d, expected %d", cnt, expected)
+	}
+
+	cnt, err = client.DraftOrder.Count(DraftOrderListOptions{Status: "open"})
+	if err != nil {
+		t.Errorf("DraftOrder.Count returned error: %v", err)
+	}
+
+	expected = 2
+	if cnt != expected {
+		t.Errorf("DraftOrder.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestDraftOrderGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/draft_orders/994118539.json",
+		httpmock.NewBytesResponder(200, loadFixture("draft_order.json")))
+
+	draftOrder, err := client.DraftOrder.Get(994118539, nil)
+	if err != nil {
+		t.Errorf("DraftOrder.Get returned error: %v", err)
+	}
+
+	draftOrderTests(t, *draftOrder)
+}
+
+func TestDraftOrderCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/draft_orders.json",
+		httpmock.NewBytesResponder(201, loadFixture("draft_order.json")))
+
+	price := decimal.NewFromFloat(199)
+	draftOrder := DraftOrder{
+		LineItems: []LineItem{
+			{
+				VariantID: 39072856,
+				Quantity:  1,
+				AppliedDiscount: &AppliedDiscount{
+					Title:     "Wholesale",
+					Value:     "10.0",
+					ValueType: "percentage",
+				},
+			},
+			{
+				Title:    "Custom Tee",
+				Price:    &price,
+				Quantity: 2,
+			},
+		},
+		Customer: &Customer{ID: 207119551},
+	}
+
+	d, err := client.DraftOrder.Create(draftOrder)
+	if err != nil {
+		t.Errorf("DraftOrder.Create returned error: %v", err)
+	}
+
+	draftOrderTests(t, *d)
+}
+
+func TestDraftOrderUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/draft_orders/1.json",
+		httpmock.NewStringResponder(200, `{"draft_order":{"id": 1, "note": "updated"}}`))
+
+	draftOrder := DraftOrder{
+		ID:   1,
+		Note: "updated",
+	}
+
+	d, err := client.DraftOrder.Update(draftOrder)
+	if err != nil {
+		t.Errorf("DraftOrder.Update returned error: %v", err)
+	}
+
+	expected := DraftOrder{ID: 1, Note: "updated"}
+	if !reflect.DeepEqual(*d, expected) {
+		t.Errorf("DraftOrder.Update returned %+v, expected %+v", d, expected)
+	}
+}
+
+func TestDraftOrderDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/draft_orders/1.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.DraftOrder.Delete(1)
+	if err != nil {
+		t.Errorf("DraftOrder.Delete returned error: %v", err)
+	}
+}
+
+func TestDraftOrderSendInvoice(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/draft_orders/1/send_invoice.json",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"draft_order_invoice":{"to":"first@example.com","subject":"Apple Computer Invoice","custom_message":"Thank you for ordering!"}}`
+			if string(body) != expected {
+				t.Errorf("DraftOrder.SendInvoice sent body %s, expected %s", body, expected)
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("draft_order_invoice.json")), nil
+		})
+
+	invoice := DraftOrderInvoice{
+		To:            "first@example.com",
+		Subject:       "Apple Computer Invoice",
+		CustomMessage: "Thank you for ordering!",
+	}
+
+	returnedInvoice, err := client.DraftOrder.SendInvoice(1, invoice)
+	if err != nil {
+		t.Errorf("DraftOrder.SendInvoice returned error: %v", err)
+	}
+
+	expected := DraftOrderInvoice{
+		To:            "first@example.com",
+		From:          "steve@apple.com",
+		Subject:       "Apple Computer Invoice",
+		CustomMessage: "Thank you for ordering!",
+		Bcc:           []string{"steve@apple.com"},
+	}
+	if !reflect.DeepEqual(*returnedInvoice, expected) {
+		t.Errorf("DraftOrder.SendInvoice returned %+v, expected %+v", returnedInvoice, expected)
+	}
+}
+
+func TestDraftOrderComplete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/draft_orders/1/complete.json",
+		httpmock.NewStringResponder(200, `{"draft_order":{"id": 1, "order_id": 2, "status": "completed"}}`))
+
+	params := map[string]string{"payment_pending": "true"}
+	httpmock.RegisterResponderWithQuery(
+		"PUT",
+		"https://fooshop.myshopify.com/admin/draft_orders/1/complete.json",
+		params,
+		httpmock.NewStringResponder(200, `{"draft_order":{"id": 1, "order_id": 3, "status": "completed"}}`))
+
+	cases := []struct {
+		paymentPending bool
+		orderID        int
+	}{
+		{false, 2},
+		{true, 3},
+	}
+
+	for _, c := range cases {
+		d, err := client.DraftOrder.Complete(1, c.paymentPending)
+		if err != nil {
+			t.Errorf("DraftOrder.Complete returned error: %v", err)
+			continue
+		}
+
+		expected := DraftOrder{ID: 1, OrderID: c.orderID, Status: "completed"}
+		if !reflect.DeepEqual(*d, expected) {
+			t.Errorf("DraftOrder.Complete(1, %v) returned %+v, expected %+v", c.paymentPending, d, expected)
+		}
+	}
+}
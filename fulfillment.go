@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -10,13 +11,21 @@ import (
 // https://help.shopify.com/api/reference/fulfillment
 type FulfillmentAPI interface {
 	List(interface{}) ([]Fulfillment, error)
+	ListWithContext(context.Context, interface{}) ([]Fulfillment, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Fulfillment, error)
+	GetWithContext(context.Context, int, interface{}) (*Fulfillment, error)
 	Create(Fulfillment) (*Fulfillment, error)
+	CreateWithContext(context.Context, Fulfillment) (*Fulfillment, error)
 	Update(Fulfillment) (*Fulfillment, error)
+	UpdateWithContext(context.Context, Fulfillment) (*Fulfillment, error)
 	Complete(int) (*Fulfillment, error)
+	CompleteWithContext(context.Context, int) (*Fulfillment, error)
 	Open(int) (*Fulfillment, error)
+	OpenWithContext(context.Context, int) (*Fulfillment, error)
 	Cancel(int) (*Fulfillment, error)
+	CancelWithContext(context.Context, int) (*Fulfillment, error)
 }
 
 // FulfillmentsAPI is an interface for other Shopify resources
@@ -24,13 +33,21 @@ type FulfillmentAPI interface {
 // https://help.shopify.com/api/reference/fulfillment
 type FulfillmentsAPI interface {
 	ListFulfillments(int, interface{}) ([]Fulfillment, error)
+	ListFulfillmentsWithContext(context.Context, int, interface{}) ([]Fulfillment, error)
 	CountFulfillments(int, interface{}) (int, error)
+	CountFulfillmentsWithContext(context.Context, int, interface{}) (int, error)
 	GetFulfillment(int, int, interface{}) (*Fulfillment, error)
+	GetFulfillmentWithContext(context.Context, int, int, interface{}) (*Fulfillment, error)
 	CreateFulfillment(int, Fulfillment) (*Fulfillment, error)
+	CreateFulfillmentWithContext(context.Context, int, Fulfillment) (*Fulfillment, error)
 	UpdateFulfillment(int, Fulfillment) (*Fulfillment, error)
+	UpdateFulfillmentWithContext(context.Context, int, Fulfillment) (*Fulfillment, error)
 	CompleteFulfillment(int, int) (*Fulfillment, error)
+	CompleteFulfillmentWithContext(context.Context, int, int) (*Fulfillment, error)
 	OpenFulfillment(int, int) (*Fulfillment, error)
+	OpenFulfillmentWithContext(context.Context, int, int) (*Fulfillment, error)
 	CancelFulfillment(int, int) (*Fulfillment, error)
+	CancelFulfillmentWithContext(context.Context, int, int) (*Fulfillment, error)
 }
 
 // FulfillmentAPIOp handles communication with the fulfillment
@@ -79,72 +96,112 @@ type FulfillmentsResource struct {
 
 // List fulfillments
 func (s *FulfillmentAPIOp) List(options interface{}) ([]Fulfillment, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *FulfillmentAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Fulfillment, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s.json", prefix)
 	resource := new(FulfillmentsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Fulfillments, err
 }
 
 // Count fulfillments
 func (s *FulfillmentAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *FulfillmentAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/count.json", prefix)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual fulfillment
 func (s *FulfillmentAPIOp) Get(fulfillmentID int, options interface{}) (*Fulfillment, error) {
+	return s.GetWithContext(context.Background(), fulfillmentID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *FulfillmentAPIOp) GetWithContext(ctx context.Context, fulfillmentID int, options interface{}) (*Fulfillment, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d.json", prefix, fulfillmentID)
 	resource := new(FulfillmentResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Fulfillment, err
 }
 
 // Create a new fulfillment
 func (s *FulfillmentAPIOp) Create(fulfillment Fulfillment) (*Fulfillment, error) {
+	return s.CreateWithContext(context.Background(), fulfillment)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *FulfillmentAPIOp) CreateWithContext(ctx context.Context, fulfillment Fulfillment) (*Fulfillment, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s.json", prefix)
 	wrappedData := FulfillmentResource{Fulfillment: &fulfillment}
 	resource := new(FulfillmentResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Fulfillment, err
 }
 
 // Update an existing fulfillment
 func (s *FulfillmentAPIOp) Update(fulfillment Fulfillment) (*Fulfillment, error) {
+	return s.UpdateWithContext(context.Background(), fulfillment)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *FulfillmentAPIOp) UpdateWithContext(ctx context.Context, fulfillment Fulfillment) (*Fulfillment, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d.json", prefix, fulfillment.ID)
 	wrappedData := FulfillmentResource{Fulfillment: &fulfillment}
 	resource := new(FulfillmentResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Fulfillment, err
 }
 
 // Complete an existing fulfillment
 func (s *FulfillmentAPIOp) Complete(fulfillmentID int) (*Fulfillment, error) {
+	return s.CompleteWithContext(context.Background(), fulfillmentID)
+}
+
+// CompleteWithContext is the context-aware variant of Complete.
+func (s *FulfillmentAPIOp) CompleteWithContext(ctx context.Context, fulfillmentID int) (*Fulfillment, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d/complete.json", prefix, fulfillmentID)
 	resource := new(FulfillmentResource)
-	err := s.client.Post(path, nil, resource)
+	err := s.client.PostWithContext(ctx, path, nil, resource)
 	return resource.Fulfillment, err
 }
 
 // Open an existing fulfillment
 func (s *FulfillmentAPIOp) Open(fulfillmentID int) (*Fulfillment, error) {
+	return s.OpenWithContext(context.Background(), fulfillmentID)
+}
+
+// OpenWithContext is the context-aware variant of Open.
+func (s *FulfillmentAPIOp) OpenWithContext(ctx context.Context, fulfillmentID int) (*Fulfillment, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d/open.json", prefix, fulfillmentID)
 	resource := new(FulfillmentResource)
-	err := s.client.Post(path, nil, resource)
+	err := s.client.PostWithContext(ctx, path, nil, resource)
 	return resource.Fulfillment, err
 }
 
 // Cancel an existing fulfillment
 func (s *FulfillmentAPIOp) Cancel(fulfillmentID int) (*Fulfillment, error) {
+	return s.CancelWithContext(context.Background(), fulfillmentID)
+}
+
+// CancelWithContext is the context-aware variant of Cancel.
+func (s *FulfillmentAPIOp) CancelWithContext(ctx context.Context, fulfillmentID int) (*Fulfillment, error) {
 	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d/cancel.json", prefix, fulfillmentID)
 	resource := new(FulfillmentResource)
-	err := s.client.Post(path, nil, resource)
+	err := s.client.PostWithContext(ctx, path, nil, resource)
 	return resource.Fulfillment, err
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -11,10 +12,15 @@ const fulfillmentServicesBasePath = "admin/fulfillment_services"
 // https://help.shopify.com/en/api/reference/shipping_and_fulfillment/fulfillmentservice
 type FulfillmentServiceAPI interface {
 	List(interface{}) ([]FulfillmentService, error)
+	ListWithContext(context.Context, interface{}) ([]FulfillmentService, error)
 	Get(int, interface{}) (*FulfillmentService, error)
+	GetWithContext(context.Context, int, interface{}) (*FulfillmentService, error)
 	Create(FulfillmentService) (*FulfillmentService, error)
+	CreateWithContext(context.Context, FulfillmentService) (*FulfillmentService, error)
 	Update(FulfillmentService) (*FulfillmentService, error)
+	UpdateWithContext(context.Context, FulfillmentService) (*FulfillmentService, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 }
 
 // FulfillmentService fulfillment service struct
@@ -53,39 +59,64 @@ type FulfillmentServicesResource struct {
 
 // List fulfillment services
 func (s *FulfillmentServiceAPIOp) List(options interface{}) ([]FulfillmentService, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *FulfillmentServiceAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]FulfillmentService, error) {
 	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
 	resource := &FulfillmentServicesResource{}
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.FulfillmentServices, err
 }
 
 // Get fulfillment service
 func (s *FulfillmentServiceAPIOp) Get(id int, options interface{}) (*FulfillmentService, error) {
+	return s.GetWithContext(context.Background(), id, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *FulfillmentServiceAPIOp) GetWithContext(ctx context.Context, id int, options interface{}) (*FulfillmentService, error) {
 	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, id)
 	resource := &FulfillmentServiceResource{}
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.FulfillmentService, err
 }
 
 // Create a fulfillment service
 func (s *FulfillmentServiceAPIOp) Create(service FulfillmentService) (*FulfillmentService, error) {
+	return s.CreateWithContext(context.Background(), service)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *FulfillmentServiceAPIOp) CreateWithContext(ctx context.Context, service FulfillmentService) (*FulfillmentService, error) {
 	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
 	wrappedData := FulfillmentServiceResource{FulfillmentService: &service}
 	resource := &FulfillmentServiceResource{}
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.FulfillmentService, err
 }
 
 // Update a fulfillment service
 func (s *FulfillmentServiceAPIOp) Update(service FulfillmentService) (*FulfillmentService, error) {
+	return s.UpdateWithContext(context.Background(), service)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *FulfillmentServiceAPIOp) UpdateWithContext(ctx context.Context, service FulfillmentService) (*FulfillmentService, error) {
 	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, service.ID)
 	wrappedData := FulfillmentServiceResource{FulfillmentService: &service}
 	resource := &FulfillmentServiceResource{}
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.FulfillmentService, err
 }
 
 // Delete a fulfillment service
 func (s *FulfillmentServiceAPIOp) Delete(id int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, id))
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *FulfillmentServiceAPIOp) DeleteWithContext(ctx context.Context, id int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, id))
 }
@@ -3,15 +3,19 @@ package goshopify
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -20,6 +24,12 @@ import (
 // UserAgent user agent
 const UserAgent = "goshopify"
 
+// apiVersionHeader reports the API version that served a request.
+const apiVersionHeader = "X-Shopify-API-Version"
+
+// apiVersionRegex matches dated API versions like "2019-04" and "unstable".
+var apiVersionRegex = regexp.MustCompile(`^([0-9]{4}-[0-9]{2}|unstable)$`)
+
 // App represents basic app settings such as Api key, secret, scope, and redirect url.
 // See oauth.go for OAuth related helper functions.
 type App struct {
@@ -28,6 +38,10 @@ type App struct {
 	RedirectURL string
 	Scope       string
 	Password    string
+
+	// APIVersion pins the clients of the app to a dated Admin API version,
+	// e.g. "2019-04". Unversioned paths are used when it is empty.
+	APIVersion string
 }
 
 // Client manages communication with the Shopify API.
@@ -43,10 +57,44 @@ type Client struct {
 	// its own client.
 	baseURL *url.URL
 
+	// The shop's full myshopify domain, used to key shared rate limits
+	shopName string
+
 	// A permanent access token
 	token string
 
+	// The User-Agent header sent with requests
+	userAgent string
+
+	// The Admin API version resource paths are rewritten to
+	apiVersion string
+
+	// The API version reported by the most recent response
+	responseAPIVersion string
+	mu                 sync.Mutex
+
+	// Retry configures how failed requests are retried. Requests are not
+	// retried when it is nil.
+	Retry *RetryPolicy
+
+	// RateLimiter throttles requests to stay within the shop's API call
+	// limit. Requests are not throttled when it is nil.
+	RateLimiter *RateLimiter
+
+	// RateLimitStore shares the API call budget of the shop with other
+	// clients using the same store. It takes precedence over RateLimiter.
+	RateLimitStore RateLimitStore
+
+	// Logger receives log records of the client's calls and retries.
+	// Nothing is logged when it is nil.
+	Logger Logger
+
+	// DebugDump additionally logs the headers and bodies of every call at
+	// Debug level, with tokens and customer data redacted.
+	DebugDump bool
+
 	// Services used for communicating with the API
+	AccessScope                AccessScopeAPI
 	ApplicationCharge          ApplicationChargeAPI
 	Asset                      AssetAPI
 	Blog                       BlogAPI
@@ -55,12 +103,17 @@ type Client struct {
 	CustomCollection           CustomCollectionAPI
 	Customer                   CustomerAPI
 	CustomerAddress            CustomerAddressAPI
+	DiscountCode               DiscountCodeAPI
+	DraftOrder                 DraftOrderAPI
 	FulfillmentService         FulfillmentServiceAPI
 	Image                      ImageAPI
+	InventoryItem              InventoryItemAPI
+	InventoryLevel             InventoryLevelAPI
 	Location                   LocationAPI
 	Metafield                  MetafieldAPI
 	Order                      OrderAPI
 	Page                       PageAPI
+	PriceRule                  PriceRuleAPI
 	Product                    ProductAPI
 	ProductListing             ProductListingAPI
 	RecurringApplicationCharge RecurringApplicationChargeAPI
@@ -123,11 +176,27 @@ type RateLimitError struct {
 // always be specified without a preceding slash. If specified, the value
 // pointed to by body is JSON encoded and included as the request body.
 func (c *Client) NewRequest(method, urlStr string, body, options interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body, options)
+}
+
+// NewRequestWithContext creates an API request like NewRequest, bound to the
+// given context. Cancelling the context or letting its deadline pass aborts
+// the request once it is sent with Do.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body, options interface{}) (*http.Request, error) {
+	if ctx == nil {
+		return nil, errors.New("nil context")
+	}
+
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 
+	// Pin relative resource paths to the API version
+	if c.apiVersion != "" && !rel.IsAbs() && rel.Host == "" {
+		rel.Path = versionedPath(rel.Path, c.apiVersion)
+	}
+
 	// Make the full url based on the relative path
 	u := c.baseURL.ResolveReference(rel)
 
@@ -160,10 +229,11 @@ func (c *Client) NewRequest(method, urlStr string, body, options interface{}) (*
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	req.Header.Add("User-Agent", UserAgent)
+	req.Header.Add("User-Agent", c.userAgent)
 	if c.token != "" {
 		req.Header.Add("X-Shopify-Access-Token", c.token)
 	} else if c.app.Password != "" {
@@ -175,20 +245,36 @@ func (c *Client) NewRequest(method, urlStr string, body, options interface{}) (*
 // NewClient returns a new Shopify API client with an already authenticated shopname and
 // token. The shopName parameter is the shop's myshopify domain,
 // e.g. "theshop.myshopify.com", or simply "theshop"
-// a.NewClient(shopName, token) is equivalent to NewClient(a, shopName, token)
-func (a App) NewClient(shopName, token string) *Client {
-	return NewClient(a, shopName, token)
+// a.NewClient(shopName, token, opts...) is equivalent to NewClient(a, shopName, token, opts...)
+func (a App) NewClient(shopName, token string, opts ...Option) (*Client, error) {
+	return NewClient(a, shopName, token, opts...)
 }
 
 // NewClient returns a new Shopify API client with an already authenticated shopname and
 // token. The shopName parameter is the shop's myshopify domain,
-// e.g. "theshop.myshopify.com", or simply "theshop"
-func NewClient(app App, shopName, token string) *Client {
-	httpClient := http.DefaultClient
+// e.g. "theshop.myshopify.com", or simply "theshop". ErrInvalidShop is
+// returned for a malformed shop name, and an error for a malformed
+// APIVersion of the app. The client is configured further with opts, e.g.
+//
+//	client, err := NewClient(app, "theshop", token,
+//		WithHTTPClient(&http.Client{Timeout: 10 * time.Second}),
+//		WithRetry(DefaultRetryPolicy()))
+func NewClient(app App, shopName, token string, opts ...Option) (*Client, error) {
+	shopName = ShopFullName(shopName)
+	if !validShopDomain(shopName) {
+		return nil, ErrInvalidShop
+	}
+	if app.APIVersion != "" && !apiVersionRegex.MatchString(app.APIVersion) {
+		return nil, fmt.Errorf("invalid API version %q", app.APIVersion)
+	}
 
-	baseURL, _ := url.Parse(ShopBaseURL(shopName))
+	baseURL, err := url.Parse(ShopBaseURL(shopName))
+	if err != nil {
+		return nil, err
+	}
 
-	c := &Client{Client: httpClient, app: app, baseURL: baseURL, token: token}
+	c := &Client{Client: http.DefaultClient, app: app, baseURL: baseURL, shopName: shopName, token: token, userAgent: UserAgent, apiVersion: app.APIVersion}
+	c.AccessScope = &AccessScopeAPIOp{client: c}
 	c.ApplicationCharge = &ApplicationChargeAPIOp{client: c}
 	c.Asset = &AssetAPIOp{client: c}
 	c.Blog = &BlogAPIOp{client: c}
@@ -197,12 +283,17 @@ func NewClient(app App, shopName, token string) *Client {
 	c.CustomCollection = &CustomCollectionAPIOp{client: c}
 	c.Customer = &CustomerAPIOp{client: c}
 	c.CustomerAddress = &CustomerAddressAPIOp{client: c}
+	c.DiscountCode = &DiscountCodeAPIOp{client: c}
+	c.DraftOrder = &DraftOrderAPIOp{client: c}
 	c.FulfillmentService = &FulfillmentServiceAPIOp{client: c}
 	c.Image = &ImageAPIOp{client: c}
+	c.InventoryItem = &InventoryItemAPIOp{client: c}
+	c.InventoryLevel = &InventoryLevelAPIOp{client: c}
 	c.Location = &LocationAPIOp{client: c}
 	c.Metafield = &MetafieldAPIOp{client: c}
 	c.Order = &OrderAPIOp{client: c}
 	c.Page = &PageAPIOp{client: c}
+	c.PriceRule = &PriceRuleAPIOp{client: c}
 	c.Product = &ProductAPIOp{client: c}
 	c.ProductListing = &ProductListingAPIOp{client: c}
 	c.RecurringApplicationCharge = &RecurringApplicationChargeAPIOp{client: c}
@@ -217,33 +308,130 @@ func NewClient(app App, shopName, token string) *Client {
 	c.Variant = &VariantAPIOp{client: c}
 	c.Webhook = &WebhookAPIOp{client: c}
 
-	return c
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// APIVersion returns the Admin API version the client requests, or an empty
+// string if the client uses unversioned paths.
+func (c *Client) APIVersion() string {
+	return c.apiVersion
+}
+
+// ResponseAPIVersion returns the API version Shopify reported in the
+// X-Shopify-API-Version header of the most recent response. It differs from
+// APIVersion when Shopify falls back to another version, e.g. after the
+// requested version is no longer supported.
+func (c *Client) ResponseAPIVersion() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.responseAPIVersion
+}
+
+// versionedPath rewrites an unversioned admin path like "admin/orders.json" to
+// "admin/api/<version>/orders.json". OAuth endpoints and paths that already
+// carry a version are left alone.
+func versionedPath(p, version string) string {
+	slash := ""
+	if strings.HasPrefix(p, "/") {
+		slash = "/"
+		p = p[1:]
+	}
+	if !strings.HasPrefix(p, "admin/") ||
+		strings.HasPrefix(p, "admin/api/") ||
+		strings.HasPrefix(p, "admin/oauth/") {
+		return slash + p
+	}
+	return fmt.Sprintf("%sadmin/api/%s/%s", slash, version, strings.TrimPrefix(p, "admin/"))
 }
 
 // Do sends an API request and populates the given interface with the parsed
 // response. It does not make much sense to call Do without a prepared
-// interface instance.
+// interface instance. Requests whose context is already done are not sent.
+// Failed requests are retried according to the client's Retry policy.
 func (c *Client) Do(req *http.Request, v interface{}) error {
+	_, err := c.doGetHeaders(req, v)
+	return err
+}
+
+// doGetHeaders sends an API request like Do and returns the headers of the
+// response.
+func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, error) {
+	if c.Retry == nil {
+		return c.do(req, v)
+	}
+
+	var headers http.Header
+	err := c.Retry.do(req, c.Logger, func(req *http.Request) error {
+		var err error
+		headers, err = c.do(req, v)
+		return err
+	})
+	return headers, err
+}
+
+// do sends a single attempt of an API request.
+func (c *Client) do(req *http.Request, v interface{}) (http.Header, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.waitForRateLimit(req.Context()); err != nil {
+		return nil, err
+	}
+
+	var reqBody []byte
+	if c.Logger != nil && c.DebugDump {
+		reqBody = dumpRequestBody(req)
+	}
+
+	start := time.Now()
 	resp, err := c.Client.Do(req)
 	if err != nil {
-		return err
+		if c.Logger != nil {
+			c.logCall(req, nil, time.Since(start), err)
+		}
+		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if c.Logger != nil {
+		c.logCall(req, resp, time.Since(start), nil)
+		if c.DebugDump {
+			respBody, err := dumpResponseBody(resp)
+			if err != nil {
+				return resp.Header, err
+			}
+			c.logDump(req, reqBody, resp, respBody)
+		}
+	}
+
+	c.updateRateLimit(req.Context(), resp)
+	if version := resp.Header.Get(apiVersionHeader); version != "" {
+		c.mu.Lock()
+		c.responseAPIVersion = version
+		c.mu.Unlock()
+	}
+
 	err = CheckResponseError(resp)
 	if err != nil {
-		return err
+		return resp.Header, err
 	}
 
 	if v != nil {
 		decoder := json.NewDecoder(resp.Body)
 		err := decoder.Decode(&v)
 		if err != nil {
-			return err
+			return resp.Header, err
 		}
 	}
 
-	return nil
+	return resp.Header, nil
 }
 
 func wrapSpecificError(r *http.Response, err ResponseError) error {
@@ -360,6 +548,64 @@ type ListOptions struct {
 	Order        string    `url:"order,omitempty"`
 	Fields       string    `url:"fields,omitempty"`
 	IDs          []int     `url:"ids,omitempty,comma"`
+	PageInfo     string    `url:"page_info,omitempty"`
+}
+
+// Pagination holds the options to request the pages next to a page of a
+// cursor-paginated list, taken from the Link header of the response. The
+// options are nil if there is no such page.
+type Pagination struct {
+	NextPageOptions     *ListOptions
+	PreviousPageOptions *ListOptions
+}
+
+// linkRegex matches the links of a Link header, e.g.
+// <https://shop.myshopify.com/admin/api/2019-07/products.json?page_info=abc&limit=3>; rel="next"
+var linkRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="(next|previous)"`)
+
+// extractPagination parses the Link header of a paginated response.
+func extractPagination(linkHeader string) (*Pagination, error) {
+	pagination := new(Pagination)
+	if linkHeader == "" {
+		return pagination, nil
+	}
+
+	matches := linkRegex.FindAllStringSubmatch(linkHeader, -1)
+	if matches == nil {
+		return nil, fmt.Errorf("could not extract pagination link header: %q", linkHeader)
+	}
+
+	for _, match := range matches {
+		rel, err := url.Parse(match[1])
+		if err != nil {
+			return nil, err
+		}
+
+		params := rel.Query()
+		pageInfo := params.Get("page_info")
+		if pageInfo == "" {
+			return nil, fmt.Errorf("page_info is missing in link header: %q", match[0])
+		}
+
+		options := &ListOptions{
+			PageInfo: pageInfo,
+			Fields:   params.Get("fields"),
+		}
+		if limit := params.Get("limit"); limit != "" {
+			options.Limit, err = strconv.Atoi(limit)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if match[2] == "next" {
+			pagination.NextPageOptions = options
+		} else {
+			pagination.PreviousPageOptions = options
+		}
+	}
+
+	return pagination, nil
 }
 
 // CountOptions general count options that can be used for most collection counts.
@@ -370,12 +616,117 @@ type CountOptions struct {
 	UpdatedAtMax time.Time `url:"updated_at_max,omitempty"`
 }
 
+// ListWithPagination performs a GET request for a cursor-paginated list like
+// Get and returns the pagination to request the next and previous pages.
+func (c *Client) ListWithPagination(path string, resource, options interface{}) (*Pagination, error) {
+	return c.ListWithPaginationWithContext(context.Background(), path, resource, options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of
+// ListWithPagination.
+func (c *Client) ListWithPaginationWithContext(ctx context.Context, path string, resource, options interface{}) (*Pagination, error) {
+	headers, err := c.createAndDoGetHeaders(ctx, "GET", path, nil, options, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractPagination(headers.Get("Link"))
+}
+
+// streamBufferSize is the number of resources buffered by the Stream methods.
+// It is the largest page size, so the next page is fetched while the
+// receiver works through the previous one.
+const streamBufferSize = 250
+
+// Pager iterates over the pages of a cursor-paginated list. It works with
+// any list endpoint and resource type:
+//
+//	pager := client.NewPager("admin/orders.json", goshopify.ListOptions{Limit: 250})
+//	for pager.HasNext() {
+//		resource := new(goshopify.OrdersResource)
+//		if err := pager.Next(resource); err != nil {
+//			return err
+//		}
+//		// Process resource.Orders
+//	}
+type Pager struct {
+	client  *Client
+	path    string
+	options interface{}
+	done    bool
+}
+
+// NewPager returns a Pager for the list at path, starting with the page
+// requested by options.
+func (c *Client) NewPager(path string, options interface{}) *Pager {
+	return &Pager{client: c, path: path, options: options}
+}
+
+// HasNext reports whether there is another page to fetch.
+func (p *Pager) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page into resource.
+func (p *Pager) Next(resource interface{}) error {
+	return p.NextWithContext(context.Background(), resource)
+}
+
+// NextWithContext is the context-aware variant of Next.
+func (p *Pager) NextWithContext(ctx context.Context, resource interface{}) error {
+	if p.done {
+		return errors.New("no more pages")
+	}
+
+	pagination, err := p.client.ListWithPaginationWithContext(ctx, p.path, resource, p.options)
+	if err != nil {
+		return err
+	}
+
+	if pagination.NextPageOptions == nil {
+		p.done = true
+	} else {
+		p.options = pagination.NextPageOptions
+	}
+	return nil
+}
+
+// listPage fetches the page of the list at path requested by options. The
+// page is decoded into a new R, whose resources are returned by items.
+func listPage[R any, T any](ctx context.Context, c *Client, path string, options interface{}, items func(*R) []T) ([]T, *Pagination, error) {
+	resource := new(R)
+	pagination, err := c.ListWithPaginationWithContext(ctx, path, resource, options)
+	return items(resource), pagination, err
+}
+
+// listAll fetches the pages of the list at path like listPage, starting
+// with the page requested by options, and returns the resources of all
+// pages. The resources of the pages fetched before an error are returned
+// along with it.
+func listAll[R any, T any](ctx context.Context, c *Client, path string, options interface{}, items func(*R) []T) ([]T, error) {
+	var all []T
+	pager := c.NewPager(path, options)
+	for pager.HasNext() {
+		resource := new(R)
+		if err := pager.NextWithContext(ctx, resource); err != nil {
+			return all, err
+		}
+		all = append(all, items(resource)...)
+	}
+	return all, nil
+}
+
 // Count count
 func (c *Client) Count(path string, options interface{}) (int, error) {
+	return c.CountWithContext(context.Background(), path, options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (c *Client) CountWithContext(ctx context.Context, path string, options interface{}) (int, error) {
 	resource := struct {
 		Count int `json:"count"`
 	}{}
-	err := c.Get(path, &resource, options)
+	err := c.GetWithContext(ctx, path, &resource, options)
 	return resource.Count, err
 }
 
@@ -389,38 +740,66 @@ func (c *Client) Count(path string, options interface{}) (int, error) {
 // parameters like created_at_min
 // Any data returned from Shopify will be marshalled into resource argument.
 func (c *Client) CreateAndDo(method, path string, data, options, resource interface{}) error {
-	req, err := c.NewRequest(method, path, data, options)
-	if err != nil {
-		return err
-	}
+	return c.CreateAndDoWithContext(context.Background(), method, path, data, options, resource)
+}
 
-	err = c.Do(req, resource)
+// CreateAndDoWithContext performs a web request like CreateAndDo, bound to
+// the given context.
+func (c *Client) CreateAndDoWithContext(ctx context.Context, method, path string, data, options, resource interface{}) error {
+	_, err := c.createAndDoGetHeaders(ctx, method, path, data, options, resource)
+	return err
+}
+
+// createAndDoGetHeaders performs a web request like CreateAndDoWithContext
+// and returns the headers of the response.
+func (c *Client) createAndDoGetHeaders(ctx context.Context, method, path string, data, options, resource interface{}) (http.Header, error) {
+	req, err := c.NewRequestWithContext(ctx, method, path, data, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return c.doGetHeaders(req, resource)
 }
 
 // Get performs a GET request for the given path and saves the result in the
 // given resource.
 func (c *Client) Get(path string, resource, options interface{}) error {
-	return c.CreateAndDo("GET", path, nil, options, resource)
+	return c.GetWithContext(context.Background(), path, resource, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (c *Client) GetWithContext(ctx context.Context, path string, resource, options interface{}) error {
+	return c.CreateAndDoWithContext(ctx, "GET", path, nil, options, resource)
 }
 
 // Post performs a POST request for the given path and saves the result in the
 // given resource.
 func (c *Client) Post(path string, data, resource interface{}) error {
-	return c.CreateAndDo("POST", path, data, nil, resource)
+	return c.PostWithContext(context.Background(), path, data, resource)
+}
+
+// PostWithContext is the context-aware variant of Post.
+func (c *Client) PostWithContext(ctx context.Context, path string, data, resource interface{}) error {
+	return c.CreateAndDoWithContext(ctx, "POST", path, data, nil, resource)
 }
 
 // Put performs a PUT request for the given path and saves the result in the
 // given resource.
 func (c *Client) Put(path string, data, resource interface{}) error {
-	return c.CreateAndDo("PUT", path, data, nil, resource)
+	return c.PutWithContext(context.Background(), path, data, resource)
+}
+
+// PutWithContext is the context-aware variant of Put.
+func (c *Client) PutWithContext(ctx context.Context, path string, data, resource interface{}) error {
+	return c.CreateAndDoWithContext(ctx, "PUT", path, data, nil, resource)
 }
 
 // Delete performs a DELETE request for the given path
 func (c *Client) Delete(path string) error {
-	return c.CreateAndDo("DELETE", path, nil, nil, nil)
+	return c.DeleteWithContext(context.Background(), path)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (c *Client) DeleteWithContext(ctx context.Context, path string) error {
+	return c.CreateAndDoWithContext(ctx, "DELETE", path, nil, nil, nil)
 }
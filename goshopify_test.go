@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
@@ -40,14 +41,38 @@ func setup() {
 		Scope:       "read_products",
 		Password:    "privateapppassword",
 	}
-	client = NewClient(app, "fooshop", "abcd")
+	client = mustNewClient(app, "fooshop", "abcd")
 	httpmock.ActivateNonDefault(client.Client)
 }
 
+// mustNewClient returns a new client like NewClient and panics if it cannot
+// be created.
+func mustNewClient(app App, shopName, token string, opts ...Option) *Client {
+	c, err := NewClient(app, shopName, token, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
 func teardown() {
 	httpmock.DeactivateAndReset()
 }
 
+// registerPages registers a responder for a cursor-paginated list at url,
+// serving the first body with a link to the second.
+func registerPages(url, first, second string) {
+	httpmock.RegisterResponder("GET", url,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("page_info") == "next" {
+				return httpmock.NewStringResponse(200, second), nil
+			}
+			resp := httpmock.NewStringResponse(200, first)
+			resp.Header.Set("Link", "<"+url+`?page_info=next&limit=2>; rel="next"`)
+			return resp, nil
+		})
+}
+
 func loadFixture(filename string) []byte {
 	f, err := ioutil.ReadFile("fixtures/" + filename)
 	if err != nil {
@@ -57,7 +82,10 @@ func loadFixture(filename string) []byte {
 }
 
 func TestNewClient(t *testing.T) {
-	testClient := NewClient(app, "fooshop", "abcd")
+	testClient, err := NewClient(app, "fooshop", "abcd")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
 	expected := "https://fooshop.myshopify.com"
 	if testClient.baseURL.String() != expected {
 		t.Errorf("NewClient BaseURL = %v, expected %v", testClient.baseURL.String(), expected)
@@ -65,15 +93,87 @@ func TestNewClient(t *testing.T) {
 }
 
 func TestNewClientWithNoToken(t *testing.T) {
-	testClient := NewClient(app, "fooshop", "")
+	testClient, err := NewClient(app, "fooshop", "")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
 	expected := "https://fooshop.myshopify.com"
 	if testClient.baseURL.String() != expected {
 		t.Errorf("NewClient BaseURL = %v, expected %v", testClient.baseURL.String(), expected)
 	}
 }
 
+func TestNewClientInvalidShop(t *testing.T) {
+	cases := []string{"", "foo shop", "-fooshop", "fooshop.example.com", "https://fooshop.myshopify.com"}
+
+	for _, shop := range cases {
+		if _, err := NewClient(app, shop, "abcd"); err != ErrInvalidShop {
+			t.Errorf("NewClient(%q) returned %v, expected ErrInvalidShop", shop, err)
+		}
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	retry := DefaultRetryPolicy()
+	limiter := NewRateLimiter()
+	store := NewMemoryRateLimitStore()
+	logger := &testLogger{}
+
+	testClient, err := NewClient(app, "fooshop", "abcd",
+		WithHTTPClient(httpClient),
+		WithBaseURL("http://localhost:8080"),
+		WithUserAgent("myapp/1.2"),
+		WithLogger(logger),
+		WithRetry(retry),
+		WithRateLimiter(limiter),
+		WithRateLimitStore(store),
+	)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	if testClient.Client != httpClient {
+		t.Errorf("NewClient Client = %v, expected %v", testClient.Client, httpClient)
+	}
+	if testClient.Retry != retry || testClient.RateLimiter != limiter || testClient.RateLimitStore != store || testClient.Logger != logger {
+		t.Errorf("NewClient did not set the retry policy, rate limiter, rate limit store and logger")
+	}
+	if testClient.shopName != "fooshop.myshopify.com" {
+		t.Errorf("NewClient shopName = %v, expected fooshop.myshopify.com", testClient.shopName)
+	}
+
+	req, err := testClient.NewRequest("GET", "admin/shop.json", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if req.URL.String() != "http://localhost:8080/admin/shop.json" {
+		t.Errorf("NewRequest URL = %v, expected http://localhost:8080/admin/shop.json", req.URL)
+	}
+	if userAgent := req.Header.Get("User-Agent"); userAgent != "goshopify myapp/1.2" {
+		t.Errorf("NewRequest() User-Agent = %v, expected goshopify myapp/1.2", userAgent)
+	}
+}
+
+func TestNewClientInvalidOptions(t *testing.T) {
+	cases := []Option{
+		WithHTTPClient(nil),
+		WithBaseURL("localhost:8080"),
+		WithBaseURL("://localhost"),
+	}
+
+	for i, opt := range cases {
+		if _, err := NewClient(app, "fooshop", "abcd", opt); err == nil {
+			t.Errorf("NewClient with invalid option %d returned nil error", i)
+		}
+	}
+}
+
 func TestAppNewClient(t *testing.T) {
-	testClient := app.NewClient("fooshop", "abcd")
+	testClient, err := app.NewClient("fooshop", "abcd")
+	if err != nil {
+		t.Fatalf("App.NewClient returned error: %v", err)
+	}
 	expected := "https://fooshop.myshopify.com"
 	if testClient.baseURL.String() != expected {
 		t.Errorf("NewClient BaseURL = %v, expected %v", testClient.baseURL.String(), expected)
@@ -81,7 +181,10 @@ func TestAppNewClient(t *testing.T) {
 }
 
 func TestAppNewClientWithNoToken(t *testing.T) {
-	testClient := app.NewClient("fooshop", "")
+	testClient, err := app.NewClient("fooshop", "")
+	if err != nil {
+		t.Fatalf("App.NewClient returned error: %v", err)
+	}
 	expected := "https://fooshop.myshopify.com"
 	if testClient.baseURL.String() != expected {
 		t.Errorf("NewClient BaseURL = %v, expected %v", testClient.baseURL.String(), expected)
@@ -89,7 +192,7 @@ func TestAppNewClientWithNoToken(t *testing.T) {
 }
 
 func TestNewRequest(t *testing.T) {
-	testClient := NewClient(app, "fooshop", "abcd")
+	testClient := mustNewClient(app, "fooshop", "abcd")
 
 	inURL, outURL := "foo?page=1", "https://fooshop.myshopify.com/foo?limit=10&page=1"
 	inBody := struct {
@@ -132,7 +235,7 @@ func TestNewRequest(t *testing.T) {
 }
 
 func TestNewRequestForPrivateApp(t *testing.T) {
-	testClient := NewClient(app, "fooshop", "")
+	testClient := mustNewClient(app, "fooshop", "")
 
 	inURL, outURL := "foo?page=1", "https://fooshop.myshopify.com/foo?limit=10&page=1"
 	inBody := struct {
@@ -189,7 +292,7 @@ func TestNewRequestForPrivateApp(t *testing.T) {
 }
 
 func TestNewRequestMissingToken(t *testing.T) {
-	testClient := NewClient(app, "fooshop", "")
+	testClient := mustNewClient(app, "fooshop", "")
 
 	req, _ := testClient.NewRequest("GET", "/foo", nil, nil)
 
@@ -200,8 +303,29 @@ func TestNewRequestMissingToken(t *testing.T) {
 	}
 }
 
+func TestNewRequestWithContext(t *testing.T) {
+	testClient := mustNewClient(app, "fooshop", "abcd")
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "bar")
+
+	req, err := testClient.NewRequestWithContext(ctx, "GET", "foo", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext() err = %v, expected nil", err)
+	}
+
+	if req.Context().Value(ctxKey{}) != "bar" {
+		t.Errorf("NewRequestWithContext() did not attach the given context to the request")
+	}
+
+	_, err = testClient.NewRequestWithContext(nil, "GET", "foo", nil, nil)
+	if err == nil {
+		t.Errorf("NewRequestWithContext(nil) err = %v, expected error", err)
+	}
+}
+
 func TestNewRequestError(t *testing.T) {
-	testClient := NewClient(app, "fooshop", "abcd")
+	testClient := mustNewClient(app, "fooshop", "abcd")
 
 	cases := []struct {
 		method  string
@@ -568,3 +692,364 @@ func TestCount(t *testing.T) {
 		t.Errorf("Client.Count returned %d, expected %d", cnt, expected)
 	}
 }
+
+func TestCreateAndDoWithContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo/1",
+		httpmock.NewStringResponder(200, `{"foo": "bar"}`))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := client.CreateAndDoWithContext(ctx, "GET", "foo/1", nil, nil, nil)
+	if err == nil {
+		t.Fatalf("CreateAndDoWithContext() err = nil, expected context.Canceled")
+	}
+	if err != context.Canceled {
+		t.Errorf("CreateAndDoWithContext() err = %v, expected context.Canceled", err)
+	}
+}
+
+func TestVersionedPath(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"admin/orders.json", "admin/api/2019-04/orders.json"},
+		{"/admin/orders/1/metafields.json", "/admin/api/2019-04/orders/1/metafields.json"},
+		{"admin/api/2019-07/orders.json", "admin/api/2019-07/orders.json"},
+		{"admin/oauth/access_token", "admin/oauth/access_token"},
+		{"foo/1", "foo/1"},
+	}
+
+	for _, c := range cases {
+		if actual := versionedPath(c.in, "2019-04"); actual != c.expected {
+			t.Errorf("versionedPath(%q) = %q, expected %q", c.in, actual, c.expected)
+		}
+	}
+}
+
+func TestNewRequestWithAPIVersion(t *testing.T) {
+	versionedApp := app
+	versionedApp.APIVersion = "2019-04"
+	testClient := mustNewClient(versionedApp, "fooshop", "abcd")
+
+	if testClient.APIVersion() != "2019-04" {
+		t.Errorf("Client.APIVersion() = %q, expected %q", testClient.APIVersion(), "2019-04")
+	}
+
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"admin/orders.json?limit=1", "https://fooshop.myshopify.com/admin/api/2019-04/orders.json?limit=1"},
+		{MetafieldPathPrefix("products", 1) + ".json", "https://fooshop.myshopify.com/admin/api/2019-04/products/1/metafields.json"},
+		{"https://fooshop.myshopify.com/admin/orders.json", "https://fooshop.myshopify.com/admin/orders.json"},
+	}
+
+	for _, c := range cases {
+		req, err := testClient.NewRequest("GET", c.in, nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest(%q) err = %v, expected nil", c.in, err)
+		}
+		if req.URL.String() != c.expected {
+			t.Errorf("NewRequest(%q) URL = %v, expected %v", c.in, req.URL, c.expected)
+		}
+	}
+
+	versionedApp.APIVersion = "april"
+	if _, err := NewClient(versionedApp, "fooshop", "abcd"); err == nil {
+		t.Errorf("NewClient() with an invalid API version err = nil, expected error")
+	}
+}
+
+func TestResponseAPIVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{}`)
+			resp.Header.Set("X-Shopify-API-Version", "2019-04")
+			return resp, nil
+		})
+
+	if version := client.ResponseAPIVersion(); version != "" {
+		t.Errorf("Client.ResponseAPIVersion() before any request = %q, expected empty", version)
+	}
+
+	if err := client.Get("foo", nil, nil); err != nil {
+		t.Fatalf("Client.Get returned error: %v", err)
+	}
+
+	if version := client.ResponseAPIVersion(); version != "2019-04" {
+		t.Errorf("Client.ResponseAPIVersion() = %q, expected %q", version, "2019-04")
+	}
+}
+
+func TestExtractPagination(t *testing.T) {
+	cases := []struct {
+		header   string
+		expected *Pagination
+		valid    bool
+	}{
+		{"", &Pagination{}, true},
+		{
+			`<https://fooshop.myshopify.com/admin/api/2019-07/products.json?page_info=abc&limit=3>; rel="next"`,
+			&Pagination{NextPageOptions: &ListOptions{PageInfo: "abc", Limit: 3}},
+			true,
+		},
+		{
+			`<https://fooshop.myshopify.com/admin/api/2019-07/products.json?limit=3&page_info=prev&fields=id,title>; rel="previous", ` +
+				`<https://fooshop.myshopify.com/admin/api/2019-07/products.json?limit=3&page_info=next&fields=id,title>; rel="next"`,
+			&Pagination{
+				NextPageOptions:     &ListOptions{PageInfo: "next", Limit: 3, Fields: "id,title"},
+				PreviousPageOptions: &ListOptions{PageInfo: "prev", Limit: 3, Fields: "id,title"},
+			},
+			true,
+		},
+		{"invalid", nil, false},
+		{`<https://fooshop.myshopify.com/admin/api/2019-07/products.json?limit=3>; rel="next"`, nil, false},
+		{`<https://fooshop.myshopify.com/admin/api/2019-07/products.json?page_info=abc&limit=x>; rel="next"`, nil, false},
+	}
+
+	for _, c := range cases {
+		pagination, err := extractPagination(c.header)
+		if (err == nil) != c.valid {
+			t.Errorf("extractPagination(%q) err = %v, expected valid %v", c.header, err, c.valid)
+		}
+		if !reflect.DeepEqual(pagination, c.expected) {
+			t.Errorf("extractPagination(%q) = %+v, expected %+v", c.header, pagination, c.expected)
+		}
+	}
+}
+
+func TestListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	failing := false
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foos.json",
+		func(req *http.Request) (*http.Response, error) {
+			switch req.URL.Query().Get("page_info") {
+			case "":
+				resp := httpmock.NewStringResponse(200, `{"foos": [1, 2]}`)
+				resp.Header.Set("Link", `<https://fooshop.myshopify.com/foos.json?page_info=two&limit=2>; rel="next"`)
+				return resp, nil
+			case "two":
+				if failing {
+					return httpmock.NewStringResponse(500, `{"errors":"Internal"}`), nil
+				}
+				resp := httpmock.NewStringResponse(200, `{"foos": [3]}`)
+				resp.Header.Set("Link", `<https://fooshop.myshopify.com/foos.json?page_info=one&limit=2>; rel="previous"`)
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(404, `{"errors":"Not Found"}`), nil
+		})
+
+	type foosResource struct {
+		Foos []int `json:"foos"`
+	}
+	foos := func(r *foosResource) []int { return r.Foos }
+	ctx := context.Background()
+
+	page, pagination, err := listPage(ctx, client, "foos.json", ListOptions{Limit: 2}, foos)
+	if err != nil {
+		t.Fatalf("listPage returned error: %v", err)
+	}
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "two", Limit: 2}}
+	if !reflect.DeepEqual(page, []int{1, 2}) || !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("listPage returned %v, %+v, expected [1 2], %+v", page, pagination, expectedPagination)
+	}
+
+	all, err := listAll(ctx, client, "foos.json", ListOptions{Limit: 2}, foos)
+	if err != nil {
+		t.Fatalf("listAll returned error: %v", err)
+	}
+	if !reflect.DeepEqual(all, []int{1, 2, 3}) {
+		t.Errorf("listAll returned %v, expected [1 2 3]", all)
+	}
+
+	failing = true
+	all, err = listAll(ctx, client, "foos.json", ListOptions{Limit: 2}, foos)
+	if e, ok := err.(ResponseError); !ok || e.Status != 500 {
+		t.Errorf("listAll with a failing page returned error %#v, expected a 500 ResponseError", err)
+	}
+	if !reflect.DeepEqual(all, []int{1, 2}) {
+		t.Errorf("listAll with a failing page returned %v, expected the first page", all)
+	}
+}
+
+func TestServiceListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		path     string
+		key      string
+		listAll  func() (interface{}, error)
+		listPage func() (interface{}, *Pagination, error)
+	}{
+		{"admin/blogs.json", "blogs",
+			func() (interface{}, error) { return client.Blog.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) { return client.Blog.ListWithPagination(ListOptions{Limit: 2}) }},
+		{"admin/collects.json", "collects",
+			func() (interface{}, error) { return client.Collect.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Collect.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/custom_collections.json", "custom_collections",
+			func() (interface{}, error) { return client.CustomCollection.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.CustomCollection.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/customers.json", "customers",
+			func() (interface{}, error) { return client.Customer.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Customer.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/price_rules/1/discount_codes.json", "discount_codes",
+			func() (interface{}, error) { return client.DiscountCode.ListAll(1, ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.DiscountCode.ListWithPagination(1, ListOptions{Limit: 2})
+			}},
+		{"admin/draft_orders.json", "draft_orders",
+			func() (interface{}, error) { return client.DraftOrder.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.DraftOrder.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/inventory_items.json", "inventory_items",
+			func() (interface{}, error) { return client.InventoryItem.ListAll(InventoryItemListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.InventoryItem.ListWithPagination(InventoryItemListOptions{Limit: 2})
+			}},
+		{"admin/inventory_levels.json", "inventory_levels",
+			func() (interface{}, error) { return client.InventoryLevel.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.InventoryLevel.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/metafields.json", "metafields",
+			func() (interface{}, error) { return client.Metafield.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Metafield.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/orders.json", "orders",
+			func() (interface{}, error) { return client.Order.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Order.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/pages.json", "pages",
+			func() (interface{}, error) { return client.Page.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) { return client.Page.ListWithPagination(ListOptions{Limit: 2}) }},
+		{"admin/price_rules.json", "price_rules",
+			func() (interface{}, error) { return client.PriceRule.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.PriceRule.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/products.json", "products",
+			func() (interface{}, error) { return client.Product.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Product.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/product_listings.json", "product_listings",
+			func() (interface{}, error) { return client.ProductListing.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.ProductListing.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/redirects.json", "redirects",
+			func() (interface{}, error) { return client.Redirect.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Redirect.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/script_tags.json", "script_tags",
+			func() (interface{}, error) { return client.ScriptTag.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.ScriptTag.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/smart_collections.json", "smart_collections",
+			func() (interface{}, error) { return client.SmartCollection.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.SmartCollection.ListWithPagination(ListOptions{Limit: 2})
+			}},
+		{"admin/products/1/variants.json", "variants",
+			func() (interface{}, error) { return client.Variant.ListAll(1, ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Variant.ListWithPagination(1, ListOptions{Limit: 2})
+			}},
+		{"admin/webhooks.json", "webhooks",
+			func() (interface{}, error) { return client.Webhook.ListAll(ListOptions{Limit: 2}) },
+			func() (interface{}, *Pagination, error) {
+				return client.Webhook.ListWithPagination(ListOptions{Limit: 2})
+			}},
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "next", Limit: 2}}
+	for _, c := range cases {
+		registerPages("https://fooshop.myshopify.com/"+c.path,
+			fmt.Sprintf(`{"%s": [{}, {}]}`, c.key), fmt.Sprintf(`{"%s": [{}]}`, c.key))
+
+		all, err := c.listAll()
+		if err != nil {
+			t.Errorf("ListAll of %s returned error: %v", c.path, err)
+		} else if n := reflect.ValueOf(all).Len(); n != 3 {
+			t.Errorf("ListAll of %s returned %d resources, expected 3", c.path, n)
+		}
+
+		page, pagination, err := c.listPage()
+		if err != nil {
+			t.Errorf("ListWithPagination of %s returned error: %v", c.path, err)
+			continue
+		}
+		if n := reflect.ValueOf(page).Len(); n != 2 {
+			t.Errorf("ListWithPagination of %s returned %d resources, expected 2", c.path, n)
+		}
+		if !reflect.DeepEqual(pagination, expectedPagination) {
+			t.Errorf("ListWithPagination of %s returned pagination %+v, expected %+v", c.path, pagination, expectedPagination)
+		}
+	}
+}
+
+func TestPager(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foos.json",
+		func(req *http.Request) (*http.Response, error) {
+			switch req.URL.Query().Get("page_info") {
+			case "":
+				resp := httpmock.NewStringResponse(200, `{"foos": [1, 2]}`)
+				resp.Header.Set("Link", `<https://fooshop.myshopify.com/foos.json?page_info=two&limit=2>; rel="next"`)
+				return resp, nil
+			case "two":
+				resp := httpmock.NewStringResponse(200, `{"foos": [3]}`)
+				resp.Header.Set("Link", `<https://fooshop.myshopify.com/foos.json?page_info=one&limit=2>; rel="previous"`)
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(404, `{"errors":"Not Found"}`), nil
+		})
+
+	type foosResource struct {
+		Foos []int `json:"foos"`
+	}
+
+	var foos []int
+	pager := client.NewPager("foos.json", ListOptions{Limit: 2})
+	for pager.HasNext() {
+		resource := new(foosResource)
+		if err := pager.Next(resource); err != nil {
+			t.Fatalf("Pager.Next returned error: %v", err)
+		}
+		foos = append(foos, resource.Foos...)
+	}
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(foos, expected) {
+		t.Errorf("Pager returned %v, expected %v", foos, expected)
+	}
+
+	if err := pager.Next(new(foosResource)); err == nil {
+		t.Errorf("Pager.Next after the last page returned nil error")
+	}
+}
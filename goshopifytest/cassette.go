@@ -0,0 +1,333 @@
+package goshopifytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	goshopify "github.com/bold-commerce/go-shopify"
+)
+
+// cassetteVersion is the version of the cassette format.
+const cassetteVersion = 1
+
+// redacted replaces secrets and personal data in cassettes.
+const redacted = "REDACTED"
+
+// oauthPath is the path of the access token requests.
+const oauthPath = "/admin/oauth/access_token"
+
+// oauthFields are the JSON fields of access token requests redacted in
+// addition to the recorder's fields. Elsewhere, e.g. in discount codes, code
+// is not a secret.
+var oauthFields = []string{
+	"code",
+	"state",
+}
+
+// Cassette is a recording of HTTP interactions with Shopify. It is stored as
+// indented JSON with sorted keys, so re-recording the same interactions
+// results in the same file.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded request. Its query is normalized, with the
+// parameters sorted by name.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response.
+type RecordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette from a file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := new(Cassette)
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("goshopifytest: invalid cassette %s: %v", path, err)
+	}
+	if cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("goshopifytest: unsupported cassette version %d in %s", cassette.Version, path)
+	}
+	return cassette, nil
+}
+
+// Save writes the cassette to a file.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Recorder is an http.RoundTripper recording the interactions of a client
+// with a shop, e.g. a development shop, into a cassette:
+//
+//	recorder := goshopifytest.NewRecorder(nil)
+//	client, err := goshopify.NewClient(app, "devshop", token,
+//		goshopify.WithHTTPClient(&http.Client{Transport: recorder}))
+//	// ... use client
+//	err = recorder.Save("testdata/products.json")
+//
+// Credentials and customer data are redacted from the cassette. Only bodies
+// and headers are redacted: query parameters are recorded as sent, since
+// replaying matches them, so requests filtering on customer data, e.g.
+// customers/search.json?query=email:..., should not be recorded into shared
+// cassettes.
+type Recorder struct {
+	// Transport sends the requests. http.DefaultTransport is used if nil.
+	Transport http.RoundTripper
+
+	// RedactedFields are the JSON fields redacted from request and
+	// response bodies, wherever they are nested.
+	RedactedFields []string
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a recorder sending requests with transport, which
+// redacts goshopify.RedactedFields.
+func NewRecorder(transport http.RoundTripper) *Recorder {
+	return &Recorder{
+		Transport:      transport,
+		RedactedFields: goshopify.RedactedFields,
+		cassette:       Cassette{Version: cassetteVersion},
+	}
+}
+
+// RoundTrip sends the request and records it and its response.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	fields := r.RedactedFields
+	if req.URL.Path == oauthPath {
+		fields = append(append([]string(nil), fields...), oauthFields...)
+	}
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  normalizeQuery(req.URL.RawQuery),
+			Header: redactHeader(req.Header),
+			Body:   redactBody(reqBody, fields),
+		},
+		Response: RecordedResponse{
+			Status: resp.StatusCode,
+			Header: redactHeader(resp.Header),
+			Body:   redactBody(respBody, fields),
+		},
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Cassette returns the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	interactions := make([]Interaction, len(r.cassette.Interactions))
+	copy(interactions, r.cassette.Interactions)
+	return &Cassette{Version: r.cassette.Version, Interactions: interactions}
+}
+
+// Save writes the interactions recorded so far to a file.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// Replayer is an http.RoundTripper answering requests with the responses
+// recorded in a cassette, without sending them:
+//
+//	cassette, err := goshopifytest.LoadCassette("testdata/products.json")
+//	client, err := goshopify.NewClient(app, "devshop", "token",
+//		goshopify.WithHTTPClient(&http.Client{Transport: goshopifytest.NewReplayer(cassette)}))
+//
+// Requests are matched with the first unused interaction with the same
+// method, path and query, so repeated requests replay their responses in
+// the recorded order. Requests matching no interaction fail with an
+// UnmatchedRequestError.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer returns a replayer of the cassette.
+func NewReplayer(cassette *Cassette) *Replayer {
+	return &Replayer{
+		interactions: cassette.Interactions,
+		used:         make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// UnmatchedRequestError is returned for requests not recorded in the
+// cassette.
+type UnmatchedRequestError struct {
+	Method string
+	Path   string
+	Query  string
+}
+
+func (e UnmatchedRequestError) Error() string {
+	target := e.Path
+	if e.Query != "" {
+		target += "?" + e.Query
+	}
+	return fmt.Sprintf("goshopifytest: no recorded interaction for %s %s", e.Method, target)
+}
+
+// RoundTrip returns the recorded response to the request.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	query := normalizeQuery(req.URL.RawQuery)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		recorded := interaction.Request
+		if r.used[i] || recorded.Method != req.Method || recorded.Path != req.URL.Path || recorded.Query != query {
+			continue
+		}
+		r.used[i] = true
+
+		header := interaction.Response.Header
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, UnmatchedRequestError{Method: req.Method, Path: req.URL.Path, Query: query}
+}
+
+// Unused returns the interactions that have not been replayed, so that
+// tests can check that all recorded requests were made.
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+// normalizeQuery sorts the parameters of a query by name, keeping the order
+// of repeated parameters.
+func normalizeQuery(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	return values.Encode()
+}
+
+// redactHeader returns a copy of header with credentials redacted.
+func redactHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	return goshopify.RedactHeader(header, redacted)
+}
+
+// redactBody redacts fields from a JSON body. Bodies that are not JSON are
+// kept as they are.
+func redactBody(body []byte, fields []string) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	redactedBody, err := goshopify.RedactJSON(body, fields, redactScalar)
+	if err != nil {
+		return string(body)
+	}
+	return string(redactedBody)
+}
+
+// redactScalar replaces a value while keeping its JSON type, so that
+// replayed bodies still decode. Numbers are replaced with 0, so redacted
+// fields like latitude replay as zero rather than the recorded values.
+func redactScalar(v interface{}) interface{} {
+	switch v.(type) {
+	case string:
+		return redacted
+	case json.Number:
+		return json.Number("0")
+	default:
+		return v
+	}
+}
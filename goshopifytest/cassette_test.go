@@ -0,0 +1,214 @@
+package goshopifytest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "goshopifytest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir returned error: %v", err)
+	}
+	return dir
+}
+
+// recordingClient returns a client for app talking to the server at baseURL
+// through a recorder.
+func recordingClient(baseURL string, app goshopify.App, token string) (*goshopify.Client, *Recorder) {
+	recorder := NewRecorder(nil)
+	client, err := goshopify.NewClient(app, ShopDomain, token,
+		goshopify.WithBaseURL(baseURL),
+		goshopify.WithHTTPClient(&http.Client{Transport: recorder}))
+	if err != nil {
+		panic(err)
+	}
+	return client, recorder
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client, recorder := recordingClient(server.URL, goshopify.App{}, "secret-token")
+
+	server.AddCustomer(goshopify.Customer{Email: "bob@example.com", FirstName: "Bob", LastName: "Norman"})
+	server.AddCustomer(goshopify.Customer{Email: "alice@example.com", FirstName: "Alice"})
+
+	recorded, err := client.Customer.List(goshopify.ListOptions{Limit: 1, SinceID: 1})
+	if err != nil {
+		t.Fatalf("Customer.List returned error: %v", err)
+	}
+	if _, err := client.Customer.Count(nil); err != nil {
+		t.Fatalf("Customer.Count returned error: %v", err)
+	}
+
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "customers.json")
+	if err := recorder.Save(path); err != nil {
+		t.Fatalf("Recorder.Save returned error: %v", err)
+	}
+
+	data, _ := ioutil.ReadFile(path)
+	for _, secret := range []string{"secret-token", "bob@example.com", "alice@example.com", "Norman"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Cassette contains %q", secret)
+		}
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette returned error: %v", err)
+	}
+	if len(cassette.Interactions) != 2 {
+		t.Fatalf("Cassette has %d interactions, expected 2", len(cassette.Interactions))
+	}
+
+	replayer := NewReplayer(cassette)
+	replay, err := goshopify.NewClient(goshopify.App{}, "othershop", "other-token",
+		goshopify.WithHTTPClient(&http.Client{Transport: replayer}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	customers, err := replay.Customer.List(goshopify.ListOptions{SinceID: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("Customer.List replay returned error: %v", err)
+	}
+	if len(customers) != 1 || customers[0].ID != recorded[0].ID || customers[0].Email != redacted {
+		t.Errorf("Customer.List replay returned %+v, expected the recorded customer with a redacted email", customers)
+	}
+
+	if unused := replayer.Unused(); len(unused) != 1 || unused[0].Request.Path != "/admin/customers/count.json" {
+		t.Errorf("Replayer.Unused returned %+v, expected the count request", unused)
+	}
+
+	count, err := replay.Customer.Count(nil)
+	if err != nil || count != 2 {
+		t.Errorf("Customer.Count replay returned %d, %v, expected 2", count, err)
+	}
+
+	_, err = replay.Customer.Count(nil)
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction for GET /admin/customers/count.json") {
+		t.Errorf("Customer.Count replayed twice returned %v, expected an unmatched request error", err)
+	}
+}
+
+func TestCassetteRedactsBasicAuth(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client, recorder := recordingClient(server.URL, goshopify.App{APIKey: "apikey", Password: "privatepass"}, "")
+
+	order := server.AddOrder(goshopify.Order{
+		Email:           "bob@example.com",
+		BillingAddress:  &goshopify.Address{Name: "Bob Norman", Address1: "123 Oak St", City: "Ottawa"},
+		ShippingAddress: &goshopify.Address{Name: "Bob Norman", Latitude: 45.4, Longitude: -75.7},
+	})
+
+	if _, err := client.Order.Get(order.ID, nil); err != nil {
+		t.Fatalf("Order.Get returned error: %v", err)
+	}
+
+	interaction := recorder.Cassette().Interactions[0]
+	if auth := interaction.Request.Header.Get("Authorization"); auth != redacted {
+		t.Errorf("Recorded Authorization header is %q, expected it to be redacted", auth)
+	}
+
+	body := interaction.Response.Body
+	for _, secret := range []string{"bob@example.com", "Bob Norman", "123 Oak St", "45.4"} {
+		if strings.Contains(body, secret) {
+			t.Errorf("Recorded response body contains %q", secret)
+		}
+	}
+	if !strings.Contains(body, "Ottawa") {
+		t.Errorf("Recorded response body %s is missing the city", body)
+	}
+}
+
+func TestCassetteRedactsOAuth(t *testing.T) {
+	shop := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == oauthPath {
+			w.Write([]byte(`{"access_token":"shpat_secret","scope":"read_products"}`))
+			return
+		}
+		w.Write([]byte(`{"discount_code":{"id":1,"code":"SUMMER"}}`))
+	}))
+	defer shop.Close()
+	client, recorder := recordingClient(shop.URL, goshopify.App{}, "")
+
+	data := map[string]string{
+		"client_id":     "apikey",
+		"client_secret": "hush",
+		"code":          "authcode",
+		"state":         "nonce",
+	}
+	if err := client.Post("admin/oauth/access_token", data, nil); err != nil {
+		t.Fatalf("Client.Post returned error: %v", err)
+	}
+	if err := client.Get("admin/price_rules/1/discount_codes/1.json", nil, nil); err != nil {
+		t.Fatalf("Client.Get returned error: %v", err)
+	}
+
+	interactions := recorder.Cassette().Interactions
+	exchange := interactions[0].Request.Body + interactions[0].Response.Body
+	for _, secret := range []string{"hush", "authcode", "nonce", "shpat_secret"} {
+		if strings.Contains(exchange, secret) {
+			t.Errorf("Recorded access token request contains %q", secret)
+		}
+	}
+	if !strings.Contains(exchange, "apikey") {
+		t.Errorf("Recorded access token request %s is missing the client ID", exchange)
+	}
+	if body := interactions[1].Response.Body; !strings.Contains(body, "SUMMER") {
+		t.Errorf("Recorded discount code %s is missing the code", body)
+	}
+}
+
+func TestReplayerNormalizesQuery(t *testing.T) {
+	replayer := NewReplayer(&Cassette{
+		Version: cassetteVersion,
+		Interactions: []Interaction{{
+			Request:  RecordedRequest{Method: "GET", Path: "/admin/products.json", Query: normalizeQuery("since_id=5&limit=2")},
+			Response: RecordedResponse{Status: http.StatusOK, Body: `{"products":[]}`},
+		}},
+	})
+
+	req, _ := http.NewRequest("GET", "https://fooshop.myshopify.com/admin/products.json?limit=2&since_id=5", nil)
+	resp, err := replayer.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Replayer.RoundTrip returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Replayer.RoundTrip returned status %d, expected 200", resp.StatusCode)
+	}
+
+	req, _ = http.NewRequest("GET", "https://fooshop.myshopify.com/admin/products.json?limit=3&since_id=5", nil)
+	_, err = replayer.RoundTrip(req)
+	if e, ok := err.(UnmatchedRequestError); !ok || e.Query != "limit=3&since_id=5" {
+		t.Errorf("Replayer.RoundTrip returned %#v, expected an UnmatchedRequestError", err)
+	}
+}
+
+func TestLoadCassetteErrors(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	if _, err := LoadCassette(filepath.Join(dir, "missing.json")); !os.IsNotExist(err) {
+		t.Errorf("LoadCassette of a missing file returned %v, expected a not exist error", err)
+	}
+
+	path := filepath.Join(dir, "future.json")
+	ioutil.WriteFile(path, []byte(`{"version": 2, "interactions": []}`), 0644)
+	if _, err := LoadCassette(path); err == nil {
+		t.Errorf("LoadCassette of an unsupported version returned nil error")
+	}
+}
@@ -0,0 +1,226 @@
+package goshopifytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const callLimitHeader = "X-Shopify-Shop-Api-Call-Limit"
+
+// Fault is a failure the server injects into its responses, e.g. to test
+// retries and error handling. Use the fault functions like Throttle or
+// ServerError to create common faults, and restrict them to some requests
+// with the Method, Path and Count fields:
+//
+//	fault := goshopifytest.ServerError(http.StatusBadGateway)
+//	fault.Path = "/admin/orders.json"
+//	fault.Count = 3
+//	server.Inject(fault)
+type Fault struct {
+	// Method restricts the fault to requests with the method if set.
+	Method string
+
+	// Path restricts the fault to requests whose unversioned path starts
+	// with it if set, e.g. "/admin/products".
+	Path string
+
+	// Count is the number of requests the fault is injected into. Zero
+	// injects it into all requests until the faults are cleared.
+	Count int
+
+	// Latency delays the response.
+	Latency time.Duration
+
+	// Status replaces the response with an error response if set.
+	Status int
+
+	// Body is the body of the error response. It defaults to the status
+	// text as Shopify's errors.
+	Body string
+
+	// Header is added to the response.
+	Header http.Header
+
+	// Truncate cuts the response body in half, so that it cannot be
+	// decoded.
+	Truncate bool
+}
+
+// Throttle returns a fault rate limiting requests with 429 Too Many Requests,
+// asking clients to retry after retryAfter. The client reads Retry-After in
+// whole seconds, so it is rounded up, e.g. to 1 for 500ms. A retryAfter of
+// zero leaves the wait to the client's backoff.
+func Throttle(retryAfter time.Duration) Fault {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	return Fault{
+		Status: http.StatusTooManyRequests,
+		Body:   `{"errors":"Exceeded 2 calls per second for api client. Reduce request rates to resume uninterrupted service."}`,
+		Header: http.Header{
+			"Retry-After": {strconv.FormatInt(seconds, 10)},
+		},
+	}
+}
+
+// CallLimit returns a fault reporting used calls of the capacity of the API
+// call bucket in successful responses. CallLimit(40, 40) saturates the
+// bucket.
+func CallLimit(used, capacity int) Fault {
+	return Fault{
+		Header: http.Header{
+			callLimitHeader: {fmt.Sprintf("%d/%d", used, capacity)},
+		},
+	}
+}
+
+// ServerError returns a fault failing requests with status, e.g.
+// http.StatusServiceUnavailable. Set Count for a burst of errors.
+func ServerError(status int) Fault {
+	return Fault{Status: status}
+}
+
+// TruncatedBody returns a fault cutting response bodies in half.
+func TruncatedBody() Fault {
+	return Fault{Truncate: true}
+}
+
+// Latency returns a fault delaying responses by d.
+func Latency(d time.Duration) Fault {
+	return Fault{Latency: d}
+}
+
+// ValidationError returns a fault failing requests with 422 Unprocessable
+// Entity and errors as Shopify's errors. Like Shopify's, errors can be a
+// string, a slice of strings or a map of fields to their errors.
+func ValidationError(errors interface{}) Fault {
+	body, err := json.Marshal(map[string]interface{}{"errors": errors})
+	if err != nil {
+		panic(fmt.Sprintf("goshopifytest: %v", err))
+	}
+	return Fault{
+		Status: http.StatusUnprocessableEntity,
+		Body:   string(body),
+	}
+}
+
+// matches reports whether the fault is injected into r.
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if f.Path != "" && !strings.HasPrefix(unversionedPath(r.URL.Path), f.Path) {
+		return false
+	}
+	return true
+}
+
+// unversionedPath strips the version from versioned Admin API paths.
+func unversionedPath(path string) string {
+	if !strings.HasPrefix(path, "/admin/") {
+		return path
+	}
+	return "/admin/" + versionPrefixRegex.ReplaceAllString(strings.TrimPrefix(path, "/admin/"), "")
+}
+
+// faultState is an injected fault and the number of requests it is still
+// injected into.
+type faultState struct {
+	fault     Fault
+	remaining int
+}
+
+// Inject adds a fault. Faults are injected in the order they were added,
+// all faults matching a request are injected into it. The first fault with
+// a Status decides the error response.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &faultState{fault: fault, remaining: fault.Count})
+}
+
+// ClearFaults removes all faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// takeFaults returns the faults to inject into r and uses them up.
+func (s *Server) takeFaults(r *http.Request) []Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var faults []Fault
+	active := s.faults[:0]
+	for _, state := range s.faults {
+		if state.fault.matches(r) {
+			faults = append(faults, state.fault)
+			if state.fault.Count > 0 {
+				state.remaining--
+				if state.remaining == 0 {
+					continue
+				}
+			}
+		}
+		active = append(active, state)
+	}
+	s.faults = active
+	return faults
+}
+
+// serveFaults serves r with next and injects faults into the response.
+func (s *Server) serveFaults(w http.ResponseWriter, r *http.Request, faults []Fault, next http.HandlerFunc) {
+	header := http.Header{}
+	truncate := false
+	var failure *Fault
+	for i, fault := range faults {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		for key, values := range fault.Header {
+			header[key] = values
+		}
+		truncate = truncate || fault.Truncate
+		if failure == nil && fault.Status != 0 {
+			failure = &faults[i]
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	if failure != nil {
+		body := failure.Body
+		if body == "" {
+			b, _ := json.Marshal(map[string]string{"errors": http.StatusText(failure.Status)})
+			body = string(b)
+		}
+		recorder.Header().Set("Content-Type", "application/json; charset=utf-8")
+		recorder.WriteHeader(failure.Status)
+		recorder.WriteString(body)
+	} else {
+		next(recorder, r)
+	}
+
+	for key, values := range recorder.Header() {
+		w.Header()[key] = values
+	}
+	for key, values := range header {
+		w.Header()[key] = values
+	}
+	body := recorder.Body.Bytes()
+	if truncate {
+		body = body[:len(body)/2]
+	}
+	w.WriteHeader(recorder.Code)
+	w.Write(body)
+}
@@ -0,0 +1,206 @@
+package goshopifytest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify"
+)
+
+// fastRetryPolicy retries without waiting long.
+func fastRetryPolicy() *goshopify.RetryPolicy {
+	policy := goshopify.DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = time.Millisecond
+	return policy
+}
+
+func TestFaultThrottle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	server.Inject(Throttle(2 * time.Second))
+
+	_, err := client.Product.Count(nil)
+	e, ok := err.(goshopify.RateLimitError)
+	if !ok {
+		t.Fatalf("Product.Count returned %#v, expected a RateLimitError", err)
+	}
+	if e.RetryAfter != 2 {
+		t.Errorf("RateLimitError.RetryAfter is %d, expected 2", e.RetryAfter)
+	}
+}
+
+func TestFaultThrottleRoundsUp(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	server.Inject(Throttle(500 * time.Millisecond))
+
+	_, err := client.Product.Count(nil)
+	if e, ok := err.(goshopify.RateLimitError); !ok || e.RetryAfter != 1 {
+		t.Errorf("Product.Count returned %#v, expected a RateLimitError to retry after 1 second", err)
+	}
+}
+
+func TestFaultThrottleRetried(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+	client.Retry = fastRetryPolicy()
+
+	var attempts int
+	client.Retry.OnRetry = func(e goshopify.RetryEvent) { attempts = e.Attempt }
+
+	fault := Throttle(0)
+	fault.Count = 2
+	server.Inject(fault)
+
+	if _, err := client.Product.Count(nil); err != nil {
+		t.Fatalf("Product.Count returned error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Product.Count was retried %d times, expected 2", attempts)
+	}
+}
+
+func TestFaultServerErrorBurst(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	fault := ServerError(http.StatusServiceUnavailable)
+	fault.Count = 2
+	server.Inject(fault)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Product.Count(nil)
+		if e, ok := err.(goshopify.ResponseError); !ok || e.Status != http.StatusServiceUnavailable {
+			t.Errorf("Product.Count returned %#v, expected a 503 ResponseError", err)
+		}
+	}
+
+	if _, err := client.Product.Count(nil); err != nil {
+		t.Errorf("Product.Count after the burst returned error: %v", err)
+	}
+}
+
+func TestFaultMatching(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{APIVersion: "2019-04"})
+
+	fault := ServerError(http.StatusInternalServerError)
+	fault.Method = http.MethodGet
+	fault.Path = "/admin/orders"
+	server.Inject(fault)
+
+	if _, err := client.Product.Count(nil); err != nil {
+		t.Errorf("Product.Count returned error: %v", err)
+	}
+	if _, err := client.Order.Create(goshopify.Order{Email: "bob@example.com"}); err != nil {
+		t.Errorf("Order.Create returned error: %v", err)
+	}
+	if _, err := client.Order.Count(nil); err == nil {
+		t.Errorf("Order.Count returned nil error")
+	}
+
+	server.ClearFaults()
+	if _, err := client.Order.Count(nil); err != nil {
+		t.Errorf("Order.Count after ClearFaults returned error: %v", err)
+	}
+}
+
+func TestFaultCallLimit(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+	client.RateLimiter = goshopify.NewRateLimiter()
+
+	fault := CallLimit(40, 40)
+	fault.Count = 1
+	server.Inject(fault)
+
+	if _, err := client.Product.Count(nil); err != nil {
+		t.Fatalf("Product.Count returned error: %v", err)
+	}
+
+	state := client.RateLimiter.State()
+	if state.Capacity != 40 || state.Used < 39 {
+		t.Errorf("RateLimiter state is %+v, expected a saturated bucket", state)
+	}
+}
+
+func TestFaultTruncatedBody(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	server.AddProduct(goshopify.Product{Title: "Shirt"})
+	server.Inject(TruncatedBody())
+
+	if _, err := client.Product.List(nil); err == nil {
+		t.Errorf("Product.List with a truncated body returned nil error")
+	}
+
+	server.Inject(ServerError(http.StatusBadGateway))
+	_, err := client.Product.List(nil)
+	if _, ok := err.(goshopify.ResponseDecodingError); !ok {
+		t.Errorf("Product.List with a truncated error returned %#v, expected a ResponseDecodingError", err)
+	}
+}
+
+func TestFaultLatency(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	server.Inject(Latency(time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.Product.CountWithContext(ctx, nil); err == nil {
+		t.Errorf("Product.CountWithContext with a short deadline returned nil error")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Product.CountWithContext returned after %v, expected the deadline to abort it", elapsed)
+	}
+}
+
+func TestFaultValidationError(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	cases := []struct {
+		errors   interface{}
+		message  string
+		expected []string
+	}{
+		{"Title is invalid", "Title is invalid", nil},
+		{[]string{"Title is invalid"}, "Title is invalid", []string{"Title is invalid"}},
+		{map[string][]string{"title": {"is too long"}}, "title: is too long", []string{"title: is too long"}},
+	}
+
+	for _, c := range cases {
+		fault := ValidationError(c.errors)
+		fault.Count = 1
+		server.Inject(fault)
+
+		_, err := client.Product.Create(goshopify.Product{Title: "Shirt"})
+		e, ok := err.(goshopify.ResponseError)
+		if !ok || e.Status != http.StatusUnprocessableEntity {
+			t.Fatalf("Product.Create returned %#v, expected a 422 ResponseError", err)
+		}
+		if e.Message != c.message || !reflect.DeepEqual(e.Errors, c.expected) {
+			t.Errorf("Product.Create returned %q %v, expected %q %v", e.Message, e.Errors, c.message, c.expected)
+		}
+	}
+}
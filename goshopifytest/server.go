@@ -0,0 +1,652 @@
+// Package goshopifytest provides a fake Shopify Admin API server for testing
+// code that uses the goshopify package.
+//
+// The server keeps products, variants, orders, customers, metafields and
+// webhooks in memory. They can be seeded with the Add methods, or through a
+// client returned by NewClient:
+//
+//	server := goshopifytest.NewServer()
+//	defer server.Close()
+//
+//	server.AddProduct(goshopify.Product{Title: "Shirt"})
+//	client := server.NewClient(goshopify.App{})
+//	products, err := client.Product.List(nil)
+//
+// Interactions with a real shop can be recorded into cassettes with a
+// Recorder, and replayed offline with a Replayer.
+package goshopifytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify"
+)
+
+// ShopDomain is the domain of the shop clients returned by NewClient talk
+// to.
+const ShopDomain = "goshopifytest.myshopify.com"
+
+const (
+	defaultLimit = 50
+	maxLimit     = 250
+)
+
+// versionPrefixRegex matches the version of versioned Admin API paths.
+var versionPrefixRegex = regexp.MustCompile(`^api/[^/]+/`)
+
+// collections maps the resource collections to the root key of their
+// objects.
+var collections = map[string]string{
+	"products":   "product",
+	"variants":   "variant",
+	"orders":     "order",
+	"customers":  "customer",
+	"metafields": "metafield",
+	"webhooks":   "webhook",
+}
+
+// object is a resource as decoded from or encoded to JSON.
+type object map[string]interface{}
+
+// Server is a fake Shopify Admin API. It supports listing, counting,
+// getting, creating, updating and deleting its resources, and filtering
+// lists with the ids, since_id and limit parameters. Lists longer than the
+// limit are paginated with Link headers. Failures can be injected into its
+// responses with Inject.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	nextID  int
+	objects map[string]map[int]object
+	faults  []*faultState
+}
+
+// NewServer starts and returns a new, empty Server. The caller should call
+// Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		nextID:  1,
+		objects: make(map[string]map[int]object),
+	}
+	for collection := range collections {
+		s.objects[collection] = make(map[int]object)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient returns a client for app talking to the server. Further options
+// can be given as for goshopify.NewClient, but the base URL is always the
+// server's.
+func (s *Server) NewClient(app goshopify.App, opts ...goshopify.Option) *goshopify.Client {
+	opts = append(opts, goshopify.WithBaseURL(s.URL))
+	client, err := goshopify.NewClient(app, ShopDomain, "goshopifytest-token", opts...)
+	if err != nil {
+		panic(fmt.Sprintf("goshopifytest: %v", err))
+	}
+	return client
+}
+
+// Reset removes all resources from the server.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for collection := range s.objects {
+		s.objects[collection] = make(map[int]object)
+	}
+}
+
+// AddProduct adds a product, including its variants, and returns it as
+// created. Like Shopify, a default variant is created if it has none.
+func (s *Server) AddProduct(product goshopify.Product) goshopify.Product {
+	var created goshopify.Product
+	s.add("products", nil, product, &created)
+	return created
+}
+
+// AddVariant adds a variant to a product and returns it as created.
+func (s *Server) AddVariant(productID int, variant goshopify.Variant) goshopify.Variant {
+	var created goshopify.Variant
+	s.add("variants", object{"product_id": productID}, variant, &created)
+	return created
+}
+
+// AddOrder adds an order and returns it as created.
+func (s *Server) AddOrder(order goshopify.Order) goshopify.Order {
+	var created goshopify.Order
+	s.add("orders", nil, order, &created)
+	return created
+}
+
+// AddCustomer adds a customer and returns it as created.
+func (s *Server) AddCustomer(customer goshopify.Customer) goshopify.Customer {
+	var created goshopify.Customer
+	s.add("customers", nil, customer, &created)
+	return created
+}
+
+// AddMetafield adds a metafield and returns it as created. The metafield
+// belongs to the shop if resource is empty, or else to the resource with
+// resourceID, e.g. "products" and the ID of a product.
+func (s *Server) AddMetafield(resource string, resourceID int, metafield goshopify.Metafield) goshopify.Metafield {
+	var created goshopify.Metafield
+	s.add("metafields", metafieldOwner(resource, resourceID), metafield, &created)
+	return created
+}
+
+// AddWebhook adds a webhook and returns it as created.
+func (s *Server) AddWebhook(webhook goshopify.Webhook) goshopify.Webhook {
+	var created goshopify.Webhook
+	s.add("webhooks", nil, webhook, &created)
+	return created
+}
+
+// add creates v in collection with the attributes of owner and decodes the
+// created object into out. It panics if v cannot be created, which is a bug
+// in the test.
+func (s *Server) add(collection string, owner object, v, out interface{}) {
+	obj, err := toObject(v)
+	if err != nil {
+		panic(fmt.Sprintf("goshopifytest: %v", err))
+	}
+	for key, value := range owner {
+		obj[key] = value
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The created object is stored, so it is decoded while holding the lock
+	// to not race with requests updating it.
+	if err := fromObject(s.create(collection, obj), out); err != nil {
+		panic(fmt.Sprintf("goshopifytest: %v", err))
+	}
+}
+
+// route is a parsed resource path.
+type route struct {
+	// collection is the collection of the resource.
+	collection string
+
+	// owner holds the attributes of the resources nested in an owner,
+	// e.g. the product_id of variants.
+	owner object
+
+	// id is the ID of a single resource, zero for the collection.
+	id int
+
+	// count is set for count endpoints.
+	count bool
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	faults := s.takeFaults(r)
+	if len(faults) == 0 {
+		s.serveResource(w, r)
+		return
+	}
+	s.serveFaults(w, r, faults, s.serveResource)
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request) {
+	rt, ok := parseRoute(r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.ownerExists(rt.owner) {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	switch {
+	case rt.count && r.Method == http.MethodGet:
+		s.count(w, r, rt)
+	case rt.id == 0 && r.Method == http.MethodGet:
+		s.list(w, r, rt)
+	case rt.id == 0 && r.Method == http.MethodPost:
+		s.post(w, r, rt)
+	case rt.id != 0 && r.Method == http.MethodGet:
+		s.get(w, rt)
+	case rt.id != 0 && r.Method == http.MethodPut:
+		s.put(w, r, rt)
+	case rt.id != 0 && r.Method == http.MethodDelete:
+		s.delete(w, rt)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// parseRoute parses paths like /admin/products/1/variants.json, optionally
+// versioned like /admin/api/2019-04/products.json.
+func parseRoute(path string) (route, bool) {
+	path = strings.TrimPrefix(path, "/")
+	if !strings.HasPrefix(path, "admin/") || !strings.HasSuffix(path, ".json") {
+		return route{}, false
+	}
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "admin/"), ".json")
+	path = versionPrefixRegex.ReplaceAllString(path, "")
+
+	var rt route
+	segments := strings.Split(path, "/")
+
+	// Nested resources: products/1/variants and <resource>/1/metafields
+	if len(segments) >= 3 {
+		ownerID, err := strconv.Atoi(segments[1])
+		if err != nil {
+			return route{}, false
+		}
+		switch {
+		case segments[0] == "products" && segments[2] == "variants":
+			rt.owner = object{"product_id": ownerID}
+		case segments[2] == "metafields":
+			rt.owner = metafieldOwner(segments[0], ownerID)
+		default:
+			return route{}, false
+		}
+		segments = segments[2:]
+	}
+
+	if _, ok := collections[segments[0]]; !ok {
+		return route{}, false
+	}
+	rt.collection = segments[0]
+	if rt.collection == "metafields" && rt.owner == nil {
+		rt.owner = metafieldOwner("", 0)
+	}
+
+	switch len(segments) {
+	case 1:
+	case 2:
+		if segments[1] == "count" {
+			rt.count = true
+			break
+		}
+		id, err := strconv.Atoi(segments[1])
+		if err != nil {
+			return route{}, false
+		}
+		rt.id = id
+	default:
+		return route{}, false
+	}
+	return rt, true
+}
+
+// metafieldOwner returns the owner attributes of the metafields of a
+// resource, e.g. "products".
+func metafieldOwner(resource string, resourceID int) object {
+	if resource == "" {
+		return object{"owner_resource": "shop"}
+	}
+	return object{
+		"owner_resource": strings.TrimSuffix(resource, "s"),
+		"owner_id":       resourceID,
+	}
+}
+
+// ownerExists reports whether the owner of nested resources exists.
+func (s *Server) ownerExists(owner object) bool {
+	if id, ok := owner["product_id"]; ok {
+		_, exists := s.objects["products"][toInt(id)]
+		return exists
+	}
+	if resource, ok := owner["owner_resource"].(string); ok && resource != "shop" {
+		objects, known := s.objects[resource+"s"]
+		if !known {
+			// Metafields of resources the server does not store.
+			return true
+		}
+		_, exists := objects[toInt(owner["owner_id"])]
+		return exists
+	}
+	return true
+}
+
+// filter returns the objects of the route matching the ids and since_id
+// parameters, ordered by ID.
+func (s *Server) filter(r *http.Request, rt route) []object {
+	query := r.URL.Query()
+
+	var ids map[int]bool
+	if query.Get("ids") != "" {
+		ids = make(map[int]bool)
+		for _, id := range strings.Split(query.Get("ids"), ",") {
+			if n, err := strconv.Atoi(strings.TrimSpace(id)); err == nil {
+				ids[n] = true
+			}
+		}
+	}
+	sinceID, _ := strconv.Atoi(query.Get("since_id"))
+	if pageInfo := query.Get("page_info"); pageInfo != "" {
+		sinceID, _ = strconv.Atoi(pageInfo)
+	}
+
+	var matches []object
+	for id, obj := range s.objects[rt.collection] {
+		if (ids != nil && !ids[id]) || id <= sinceID || !owns(rt.owner, obj) {
+			continue
+		}
+		matches = append(matches, obj)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return toInt(matches[i]["id"]) < toInt(matches[j]["id"])
+	})
+	return matches
+}
+
+// owns reports whether obj is nested in owner.
+func owns(owner, obj object) bool {
+	for key, value := range owner {
+		if fmt.Sprint(obj[key]) != fmt.Sprint(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) count(w http.ResponseWriter, r *http.Request, rt route) {
+	writeJSON(w, http.StatusOK, object{"count": len(s.filter(r, rt))})
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, rt route) {
+	limit := defaultLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n < 1 || n > maxLimit {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxLimit))
+			return
+		}
+		limit = n
+	}
+
+	matches := s.filter(r, rt)
+	if len(matches) > limit {
+		matches = matches[:limit]
+		next := url.URL{
+			Scheme:   "https",
+			Host:     ShopDomain,
+			Path:     r.URL.Path,
+			RawQuery: url.Values{"limit": {strconv.Itoa(limit)}, "page_info": {strconv.Itoa(toInt(matches[limit-1]["id"]))}}.Encode(),
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+	}
+
+	list := make([]object, 0, len(matches))
+	for _, obj := range matches {
+		list = append(list, s.render(rt.collection, obj))
+	}
+	writeJSON(w, http.StatusOK, object{rt.collection: list})
+}
+
+func (s *Server) get(w http.ResponseWriter, rt route) {
+	obj, ok := s.lookup(rt)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{collections[rt.collection]: s.render(rt.collection, obj)})
+}
+
+func (s *Server) post(w http.ResponseWriter, r *http.Request, rt route) {
+	obj, ok := readObject(w, r, collections[rt.collection])
+	if !ok {
+		return
+	}
+	for key, value := range rt.owner {
+		obj[key] = value
+	}
+	if errs := validate(rt.collection, obj); errs != nil {
+		writeJSON(w, http.StatusUnprocessableEntity, object{"errors": errs})
+		return
+	}
+
+	created := s.create(rt.collection, obj)
+	writeJSON(w, http.StatusCreated, object{collections[rt.collection]: s.render(rt.collection, created)})
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, rt route) {
+	obj, ok := s.lookup(rt)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	changes, ok := readObject(w, r, collections[rt.collection])
+	if !ok {
+		return
+	}
+
+	updated := object{}
+	for key, value := range obj {
+		updated[key] = value
+	}
+	variants, _ := changes["variants"].([]interface{})
+	delete(changes, "variants")
+	for key, value := range changes {
+		updated[key] = value
+	}
+	updated["id"] = rt.id
+	if errs := validate(rt.collection, updated); errs != nil {
+		writeJSON(w, http.StatusUnprocessableEntity, object{"errors": errs})
+		return
+	}
+
+	updated["updated_at"] = now()
+	s.objects[rt.collection][rt.id] = updated
+	if rt.collection == "products" {
+		s.saveVariants(rt.id, variants)
+	}
+	writeJSON(w, http.StatusOK, object{collections[rt.collection]: s.render(rt.collection, updated)})
+}
+
+func (s *Server) delete(w http.ResponseWriter, rt route) {
+	if _, ok := s.lookup(rt); !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	delete(s.objects[rt.collection], rt.id)
+	if rt.collection == "products" {
+		for id, variant := range s.objects["variants"] {
+			if toInt(variant["product_id"]) == rt.id {
+				delete(s.objects["variants"], id)
+			}
+		}
+	}
+	for id, metafield := range s.objects["metafields"] {
+		if metafield["owner_resource"] == collections[rt.collection] && toInt(metafield["owner_id"]) == rt.id {
+			delete(s.objects["metafields"], id)
+		}
+	}
+	writeJSON(w, http.StatusOK, object{})
+}
+
+// lookup returns the object of the route, which must be nested in the
+// route's owner.
+func (s *Server) lookup(rt route) (object, bool) {
+	obj, ok := s.objects[rt.collection][rt.id]
+	if !ok || !owns(rt.owner, obj) {
+		return nil, false
+	}
+	return obj, true
+}
+
+// create stores a new object in collection and returns it. The variants of
+// products are stored separately. It must be called with s.mu held.
+func (s *Server) create(collection string, obj object) object {
+	variants, _ := obj["variants"].([]interface{})
+	delete(obj, "variants")
+
+	id := s.newID()
+	timestamp := now()
+	obj["id"] = id
+	obj["created_at"] = timestamp
+	obj["updated_at"] = timestamp
+	s.objects[collection][id] = obj
+
+	if collection == "products" {
+		if len(variants) == 0 {
+			variants = []interface{}{map[string]interface{}{"title": "Default Title", "price": "0.00"}}
+		}
+		s.saveVariants(id, variants)
+	}
+	return s.render(collection, obj)
+}
+
+// saveVariants creates the variants of a product without an ID and updates
+// those with one. It must be called with s.mu held.
+func (s *Server) saveVariants(productID int, variants []interface{}) {
+	for _, v := range variants {
+		variant, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		variant["product_id"] = productID
+
+		id := toInt(variant["id"])
+		existing, ok := s.objects["variants"][id]
+		if !ok || toInt(existing["product_id"]) != productID {
+			s.create("variants", object(variant))
+			continue
+		}
+		for key, value := range variant {
+			existing[key] = value
+		}
+		existing["updated_at"] = now()
+	}
+}
+
+// render returns obj as served. Products include their variants.
+func (s *Server) render(collection string, obj object) object {
+	if collection != "products" {
+		return obj
+	}
+
+	rendered := object{}
+	for key, value := range obj {
+		rendered[key] = value
+	}
+	variants := s.filter(&http.Request{URL: &url.URL{}}, route{
+		collection: "variants",
+		owner:      object{"product_id": obj["id"]},
+	})
+	rendered["variants"] = variants
+	return rendered
+}
+
+func (s *Server) newID() int {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+// validate returns the validation errors of obj like Shopify, or nil if it
+// is valid.
+func validate(collection string, obj object) map[string][]string {
+	var required []string
+	switch collection {
+	case "products":
+		required = []string{"title"}
+	case "metafields":
+		required = []string{"namespace", "key", "value"}
+	case "webhooks":
+		required = []string{"topic", "address"}
+	}
+
+	errs := make(map[string][]string)
+	for _, field := range required {
+		if value, ok := obj[field]; !ok || value == nil || value == "" {
+			errs[field] = []string{"can't be blank"}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// readObject decodes the object wrapped in root from the request body. It
+// responds with an error and returns false if the body is invalid.
+func readObject(w http.ResponseWriter, r *http.Request, root string) (object, bool) {
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+
+	var body map[string]object
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON: "+err.Error())
+		return nil, false
+	}
+	obj, ok := body[root]
+	if !ok || obj == nil {
+		writeJSON(w, http.StatusBadRequest, object{"errors": object{root: "Required parameter missing or invalid"}})
+		return nil, false
+	}
+	return obj, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{"errors": message})
+}
+
+// toObject converts v to an object through JSON.
+func toObject(v interface{}) (object, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(b)))
+	decoder.UseNumber()
+	obj := object{}
+	err = decoder.Decode(&obj)
+	return obj, err
+}
+
+// fromObject converts obj to out through JSON.
+func fromObject(obj object, out interface{}) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// toInt converts a JSON number to an int, or returns zero.
+func toInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	case json.Number:
+		i, _ := strconv.Atoi(n.String())
+		return i
+	}
+	return 0
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
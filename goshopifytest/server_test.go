@@ -0,0 +1,307 @@
+package goshopifytest
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify"
+)
+
+func TestServerProducts(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	product, err := client.Product.Create(goshopify.Product{
+		Title:    "Shirt",
+		Variants: []goshopify.Variant{{Title: "Small"}, {Title: "Large"}},
+	})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if product.ID == 0 || product.CreatedAt == nil {
+		t.Errorf("Product.Create returned %+v, expected an ID and creation time", product)
+	}
+	if len(product.Variants) != 2 || product.Variants[0].ProductID != product.ID {
+		t.Errorf("Product.Create returned variants %+v, expected 2 variants of the product", product.Variants)
+	}
+
+	variantID := product.Variants[0].ID
+	product.Title = "T-Shirt"
+	product.Variants = nil
+	updated, err := client.Product.Update(*product)
+	if err != nil {
+		t.Fatalf("Product.Update returned error: %v", err)
+	}
+	if updated.Title != "T-Shirt" || len(updated.Variants) != 2 {
+		t.Errorf("Product.Update returned %+v, expected the new title and the variants", updated)
+	}
+
+	fetched, err := client.Product.Get(product.ID, nil)
+	if err != nil {
+		t.Fatalf("Product.Get returned error: %v", err)
+	}
+	if fetched.Title != "T-Shirt" {
+		t.Errorf("Product.Get returned title %s, expected T-Shirt", fetched.Title)
+	}
+
+	if err := client.Product.Delete(product.ID); err != nil {
+		t.Fatalf("Product.Delete returned error: %v", err)
+	}
+	_, err = client.Product.Get(product.ID, nil)
+	if e, ok := err.(goshopify.ResponseError); !ok || e.Status != http.StatusNotFound {
+		t.Errorf("Product.Get of a deleted product returned %#v, expected a 404 ResponseError", err)
+	}
+	if _, err := client.Variant.Get(variantID, nil); err == nil {
+		t.Errorf("Variant.Get of a deleted product's variant returned nil error")
+	}
+}
+
+func TestServerProductDefaultVariant(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	product := server.AddProduct(goshopify.Product{Title: "Shirt"})
+	if len(product.Variants) != 1 || product.Variants[0].Title != "Default Title" {
+		t.Errorf("AddProduct returned variants %+v, expected the default variant", product.Variants)
+	}
+}
+
+func TestServerValidation(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	_, err := client.Product.Create(goshopify.Product{Vendor: "Acme"})
+	e, ok := err.(goshopify.ResponseError)
+	if !ok || e.Status != http.StatusUnprocessableEntity {
+		t.Fatalf("Product.Create without title returned %#v, expected a 422 ResponseError", err)
+	}
+	if len(e.Errors) != 1 || e.Errors[0] != "title: can't be blank" {
+		t.Errorf("Product.Create without title returned errors %v", e.Errors)
+	}
+}
+
+func TestServerListFilters(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	var ids []int
+	for i := 0; i < 5; i++ {
+		ids = append(ids, server.AddCustomer(goshopify.Customer{Email: "bob@example.com"}).ID)
+	}
+
+	cases := []struct {
+		options  interface{}
+		expected []int
+	}{
+		{nil, ids},
+		{goshopify.ListOptions{Limit: 2}, ids[:2]},
+		{goshopify.ListOptions{SinceID: ids[2]}, ids[3:]},
+		{goshopify.ListOptions{IDs: []int{ids[4], ids[1]}}, []int{ids[1], ids[4]}},
+		{goshopify.ListOptions{IDs: []int{ids[4], ids[1], ids[0]}, Limit: 2}, []int{ids[0], ids[1]}},
+	}
+
+	for _, c := range cases {
+		customers, err := client.Customer.List(c.options)
+		if err != nil {
+			t.Fatalf("Customer.List(%+v) returned error: %v", c.options, err)
+		}
+		var actual []int
+		for _, customer := range customers {
+			actual = append(actual, customer.ID)
+		}
+		if !equalInts(actual, c.expected) {
+			t.Errorf("Customer.List(%+v) returned %v, expected %v", c.options, actual, c.expected)
+		}
+	}
+
+	count, err := client.Customer.Count(goshopify.ListOptions{SinceID: ids[1]})
+	if err != nil {
+		t.Fatalf("Customer.Count returned error: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Customer.Count returned %d, expected 3", count)
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	for i := 0; i < 5; i++ {
+		server.AddOrder(goshopify.Order{Email: "bob@example.com"})
+	}
+
+	orders, err := client.Order.ListAll(goshopify.ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Order.ListAll returned error: %v", err)
+	}
+	if len(orders) != 5 {
+		t.Errorf("Order.ListAll returned %d orders, expected 5", len(orders))
+	}
+}
+
+func TestServerVariants(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	product := server.AddProduct(goshopify.Product{Title: "Shirt"})
+	other := server.AddProduct(goshopify.Product{Title: "Hat"})
+
+	variant, err := client.Variant.Create(product.ID, goshopify.Variant{Title: "Large"})
+	if err != nil {
+		t.Fatalf("Variant.Create returned error: %v", err)
+	}
+	if variant.ProductID != product.ID {
+		t.Errorf("Variant.Create returned product ID %d, expected %d", variant.ProductID, product.ID)
+	}
+
+	count, err := client.Variant.Count(product.ID, nil)
+	if err != nil || count != 2 {
+		t.Errorf("Variant.Count returned %d, %v, expected 2", count, err)
+	}
+
+	if err := client.Variant.Delete(other.ID, variant.ID); err == nil {
+		t.Errorf("Variant.Delete of another product's variant returned nil error")
+	}
+	if err := client.Variant.Delete(product.ID, variant.ID); err != nil {
+		t.Errorf("Variant.Delete returned error: %v", err)
+	}
+
+	if _, err := client.Variant.List(12345, nil); err == nil {
+		t.Errorf("Variant.List of a missing product returned nil error")
+	}
+}
+
+func TestServerMetafields(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	product := server.AddProduct(goshopify.Product{Title: "Shirt"})
+	server.AddMetafield("", 0, goshopify.Metafield{Namespace: "shop", Key: "theme", Value: "dark", ValueType: "string"})
+
+	metafield, err := client.Product.CreateMetafield(product.ID, goshopify.Metafield{
+		Namespace: "inventory",
+		Key:       "warehouse",
+		Value:     "25",
+		ValueType: "string",
+	})
+	if err != nil {
+		t.Fatalf("Product.CreateMetafield returned error: %v", err)
+	}
+	if metafield.OwnerResource != "product" || metafield.OwnerID != product.ID {
+		t.Errorf("Product.CreateMetafield returned owner %s %d, expected product %d", metafield.OwnerResource, metafield.OwnerID, product.ID)
+	}
+
+	metafields, err := client.Product.ListMetafields(product.ID, nil)
+	if err != nil || len(metafields) != 1 {
+		t.Errorf("Product.ListMetafields returned %+v, %v, expected 1 metafield", metafields, err)
+	}
+
+	shopMetafields, err := client.Metafield.List(nil)
+	if err != nil || len(shopMetafields) != 1 || shopMetafields[0].Key != "theme" {
+		t.Errorf("Metafield.List returned %+v, %v, expected the theme metafield", shopMetafields, err)
+	}
+
+	if _, err := client.Product.CreateMetafield(product.ID, goshopify.Metafield{Key: "warehouse"}); err == nil {
+		t.Errorf("Product.CreateMetafield without namespace and value returned nil error")
+	}
+}
+
+func TestServerWebhooks(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	server.AddWebhook(goshopify.Webhook{Topic: "orders/create", Address: "https://example.com/hooks", Format: "json"})
+	server.AddWebhook(goshopify.Webhook{Topic: "orders/updated", Address: "https://example.com/hooks", Format: "json"})
+
+	report, err := client.Webhook.SyncWithOptions([]goshopify.Webhook{
+		{Topic: "orders/create", Address: "https://example.com/hooks", Format: "json"},
+		{Topic: "app/uninstalled", Address: "https://example.com/hooks", Format: "json"},
+	}, goshopify.SyncOptions{DeleteExtras: true})
+	if err != nil {
+		t.Fatalf("Webhook.SyncWithOptions returned error: %v", err)
+	}
+	if len(report.Created) != 1 || len(report.Deleted) != 1 || len(report.Unchanged) != 1 {
+		t.Errorf("Webhook.SyncWithOptions returned %+v, expected 1 created, deleted and unchanged webhook", report)
+	}
+
+	count, err := client.Webhook.Count(nil)
+	if err != nil || count != 2 {
+		t.Errorf("Webhook.Count returned %d, %v, expected 2", count, err)
+	}
+}
+
+func TestServerVersionedPaths(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{APIVersion: "2019-04"})
+
+	server.AddProduct(goshopify.Product{Title: "Shirt"})
+	count, err := client.Product.Count(nil)
+	if err != nil || count != 1 {
+		t.Errorf("Product.Count returned %d, %v, expected 1", count, err)
+	}
+}
+
+func TestServerReset(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	server.AddProduct(goshopify.Product{Title: "Shirt"})
+	server.Reset()
+
+	count, err := client.Product.Count(nil)
+	if err != nil || count != 0 {
+		t.Errorf("Product.Count after Reset returned %d, %v, expected 0", count, err)
+	}
+}
+
+func TestServerConcurrentUse(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.NewClient(goshopify.App{})
+
+	product := server.AddProduct(goshopify.Product{Title: "Shirt"})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			server.AddProduct(goshopify.Product{Title: "Hat"})
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := client.Product.Update(product); err != nil {
+				t.Errorf("Product.Update returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	count, err := client.Product.Count(nil)
+	if err != nil || count != 5 {
+		t.Errorf("Product.Count returned %d, %v, expected 5", count, err)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
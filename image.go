@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -10,11 +11,17 @@ import (
 // See https://help.shopify.com/api/reference/product_image
 type ImageAPI interface {
 	List(int, interface{}) ([]Image, error)
+	ListWithContext(context.Context, int, interface{}) ([]Image, error)
 	Count(int, interface{}) (int, error)
+	CountWithContext(context.Context, int, interface{}) (int, error)
 	Get(int, int, interface{}) (*Image, error)
+	GetWithContext(context.Context, int, int, interface{}) (*Image, error)
 	Create(int, Image) (*Image, error)
+	CreateWithContext(context.Context, int, Image) (*Image, error)
 	Update(int, Image) (*Image, error)
+	UpdateWithContext(context.Context, int, Image) (*Image, error)
 	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
 }
 
 // ImageAPIOp handles communication with the image related methods of
@@ -50,23 +57,38 @@ type ImagesResource struct {
 
 // List images
 func (s *ImageAPIOp) List(productID int, options interface{}) ([]Image, error) {
+	return s.ListWithContext(context.Background(), productID, options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ImageAPIOp) ListWithContext(ctx context.Context, productID int, options interface{}) ([]Image, error) {
 	path := fmt.Sprintf("%s/%d/images.json", productsBasePath, productID)
 	resource := new(ImagesResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Images, err
 }
 
 // Count images
 func (s *ImageAPIOp) Count(productID int, options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), productID, options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *ImageAPIOp) CountWithContext(ctx context.Context, productID int, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/%d/images/count.json", productsBasePath, productID)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual image
 func (s *ImageAPIOp) Get(productID int, imageID int, options interface{}) (*Image, error) {
+	return s.GetWithContext(context.Background(), productID, imageID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ImageAPIOp) GetWithContext(ctx context.Context, productID int, imageID int, options interface{}) (*Image, error) {
 	path := fmt.Sprintf("%s/%d/images/%d.json", productsBasePath, productID, imageID)
 	resource := new(ImageResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Image, err
 }
 
@@ -84,23 +106,38 @@ func (s *ImageAPIOp) Get(productID int, imageID int, options interface{}) (*Imag
 //
 // Shopify will accept Image.Attachment without Image.Filename.
 func (s *ImageAPIOp) Create(productID int, image Image) (*Image, error) {
+	return s.CreateWithContext(context.Background(), productID, image)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ImageAPIOp) CreateWithContext(ctx context.Context, productID int, image Image) (*Image, error) {
 	path := fmt.Sprintf("%s/%d/images.json", productsBasePath, productID)
 	wrappedData := ImageResource{Image: &image}
 	resource := new(ImageResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Image, err
 }
 
 // Update an existing image
 func (s *ImageAPIOp) Update(productID int, image Image) (*Image, error) {
+	return s.UpdateWithContext(context.Background(), productID, image)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ImageAPIOp) UpdateWithContext(ctx context.Context, productID int, image Image) (*Image, error) {
 	path := fmt.Sprintf("%s/%d/images/%d.json", productsBasePath, productID, image.ID)
 	wrappedData := ImageResource{Image: &image}
 	resource := new(ImageResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Image, err
 }
 
 // Delete an existing image
 func (s *ImageAPIOp) Delete(productID int, imageID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d/images/%d.json", productsBasePath, productID, imageID))
+	return s.DeleteWithContext(context.Background(), productID, imageID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ImageAPIOp) DeleteWithContext(ctx context.Context, productID int, imageID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d/images/%d.json", productsBasePath, productID, imageID))
 }
@@ -0,0 +1,132 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const inventoryItemsBasePath = "admin/inventory_items"
+
+// InventoryItemAPI is an interface for interfacing with the inventory items
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/en/api/reference/inventory/inventoryitem
+type InventoryItemAPI interface {
+	List(interface{}) ([]InventoryItem, error)
+	ListWithContext(context.Context, interface{}) ([]InventoryItem, error)
+	ListWithPagination(interface{}) ([]InventoryItem, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]InventoryItem, *Pagination, error)
+	ListAll(interface{}) ([]InventoryItem, error)
+	ListAllWithContext(context.Context, interface{}) ([]InventoryItem, error)
+	Get(int, interface{}) (*InventoryItem, error)
+	GetWithContext(context.Context, int, interface{}) (*InventoryItem, error)
+	Update(InventoryItem) (*InventoryItem, error)
+	UpdateWithContext(context.Context, InventoryItem) (*InventoryItem, error)
+}
+
+// InventoryItemAPIOp handles communication with the inventory item related
+// methods of the Shopify API.
+type InventoryItemAPIOp struct {
+	client *Client
+}
+
+// InventoryItemListOptions a struct for all available inventory item list
+// options. Shopify requires IDs to be set.
+type InventoryItemListOptions struct {
+	IDs      []int  `url:"ids,omitempty,comma"`
+	Limit    int    `url:"limit,omitempty"`
+	PageInfo string `url:"page_info,omitempty"`
+}
+
+// InventoryItem represents a Shopify inventory item, the stock keeping unit
+// behind a product variant
+type InventoryItem struct {
+	ID                   int              `json:"id,omitempty"`
+	SKU                  string           `json:"sku,omitempty"`
+	CreatedAt            *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt            *time.Time       `json:"updated_at,omitempty"`
+	Cost                 *decimal.Decimal `json:"cost,omitempty"`
+	Tracked              *bool            `json:"tracked,omitempty"`
+	RequiresShipping     *bool            `json:"requires_shipping,omitempty"`
+	CountryCodeOfOrigin  string           `json:"country_code_of_origin,omitempty"`
+	ProvinceCodeOfOrigin string           `json:"province_code_of_origin,omitempty"`
+	HarmonizedSystemCode string           `json:"harmonized_system_code,omitempty"`
+}
+
+// InventoryItemResource represents the result from the inventory_items/X.json
+// endpoint
+type InventoryItemResource struct {
+	InventoryItem *InventoryItem `json:"inventory_item"`
+}
+
+// InventoryItemsResource represents the result from the inventory_items.json
+// endpoint
+type InventoryItemsResource struct {
+	InventoryItems []InventoryItem `json:"inventory_items"`
+}
+
+// List inventory items
+func (s *InventoryItemAPIOp) List(options interface{}) ([]InventoryItem, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *InventoryItemAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]InventoryItem, error) {
+	path := fmt.Sprintf("%s.json", inventoryItemsBasePath)
+	resource := new(InventoryItemsResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.InventoryItems, err
+}
+
+// ListWithPagination lists inventory items and returns the pagination to
+// request the next and previous pages.
+func (s *InventoryItemAPIOp) ListWithPagination(options interface{}) ([]InventoryItem, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *InventoryItemAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]InventoryItem, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", inventoryItemsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *InventoryItemsResource) []InventoryItem { return r.InventoryItems })
+}
+
+// ListAll lists all inventory items, following the pagination from the page
+// requested by options to the last page.
+func (s *InventoryItemAPIOp) ListAll(options interface{}) ([]InventoryItem, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *InventoryItemAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]InventoryItem, error) {
+	path := fmt.Sprintf("%s.json", inventoryItemsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *InventoryItemsResource) []InventoryItem { return r.InventoryItems })
+}
+
+// Get individual inventory item
+func (s *InventoryItemAPIOp) Get(inventoryItemID int, options interface{}) (*InventoryItem, error) {
+	return s.GetWithContext(context.Background(), inventoryItemID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *InventoryItemAPIOp) GetWithContext(ctx context.Context, inventoryItemID int, options interface{}) (*InventoryItem, error) {
+	path := fmt.Sprintf("%s/%d.json", inventoryItemsBasePath, inventoryItemID)
+	resource := new(InventoryItemResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.InventoryItem, err
+}
+
+// Update inventory item
+func (s *InventoryItemAPIOp) Update(item InventoryItem) (*InventoryItem, error) {
+	return s.UpdateWithContext(context.Background(), item)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *InventoryItemAPIOp) UpdateWithContext(ctx context.Context, item InventoryItem) (*InventoryItem, error) {
+	path := fmt.Sprintf("%s/%d.json", inventoryItemsBasePath, item.ID)
+	wrappedData := InventoryItemResource{InventoryItem: &item}
+	resource := new(InventoryItemResource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
+	return resource.InventoryItem, err
+}
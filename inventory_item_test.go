@@ -0,0 +1,92 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func inventoryItemTests(t *testing.T, item InventoryItem) {
+	if item.ID != 808950810 {
+		t.Errorf("InventoryItem.ID returned %d, expected %d", item.ID, 808950810)
+	}
+
+	cost := decimal.NewFromFloat(25)
+	if item.Cost == nil || !cost.Equals(*item.Cost) {
+		t.Errorf("InventoryItem.Cost returned %v, expected %v", item.Cost, cost)
+	}
+
+	if item.Tracked == nil || !*item.Tracked {
+		t.Errorf("InventoryItem.Tracked returned %v, expected true", item.Tracked)
+	}
+
+	if item.CountryCodeOfOrigin != "CN" {
+		t.Errorf("InventoryItem.CountryCodeOfOrigin returned %q, expected %q", item.CountryCodeOfOrigin, "CN")
+	}
+
+	if item.HarmonizedSystemCode != "851712" {
+		t.Errorf("InventoryItem.HarmonizedSystemCode returned %q, expected %q", item.HarmonizedSystemCode, "851712")
+	}
+}
+
+func TestInventoryItemList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"ids": "1,2"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		"https://fooshop.myshopify.com/admin/inventory_items.json",
+		params,
+		httpmock.NewStringResponder(200, `{"inventory_items": [{"id":1},{"id":2}]}`))
+
+	items, err := client.InventoryItem.List(InventoryItemListOptions{IDs: []int{1, 2}})
+	if err != nil {
+		t.Errorf("InventoryItem.List returned error: %v", err)
+	}
+
+	expected := []InventoryItem{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("InventoryItem.List returned %+v, expected %+v", items, expected)
+	}
+}
+
+func TestInventoryItemGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/inventory_items/808950810.json",
+		httpmock.NewBytesResponder(200, loadFixture("inventory_item.json")))
+
+	item, err := client.InventoryItem.Get(808950810, nil)
+	if err != nil {
+		t.Errorf("InventoryItem.Get returned error: %v", err)
+	}
+
+	inventoryItemTests(t, *item)
+}
+
+func TestInventoryItemUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/inventory_items/808950810.json",
+		httpmock.NewBytesResponder(200, loadFixture("inventory_item.json")))
+
+	cost := decimal.NewFromFloat(25)
+	item := InventoryItem{
+		ID:                   808950810,
+		Cost:                 &cost,
+		CountryCodeOfOrigin:  "CN",
+		HarmonizedSystemCode: "851712",
+	}
+
+	returnedItem, err := client.InventoryItem.Update(item)
+	if err != nil {
+		t.Errorf("InventoryItem.Update returned error: %v", err)
+	}
+
+	inventoryItemTests(t, *returnedItem)
+}
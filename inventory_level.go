@@ -0,0 +1,189 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const inventoryLevelsBasePath = "admin/inventory_levels"
+
+// InventoryLevelAPI is an interface for interfacing with the inventory levels
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/en/api/reference/inventory/inventorylevel
+type InventoryLevelAPI interface {
+	List(interface{}) ([]InventoryLevel, error)
+	ListWithContext(context.Context, interface{}) ([]InventoryLevel, error)
+	ListWithPagination(interface{}) ([]InventoryLevel, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]InventoryLevel, *Pagination, error)
+	ListAll(interface{}) ([]InventoryLevel, error)
+	ListAllWithContext(context.Context, interface{}) ([]InventoryLevel, error)
+	Adjust(InventoryLevelAdjustOptions) (*InventoryLevel, error)
+	AdjustWithContext(context.Context, InventoryLevelAdjustOptions) (*InventoryLevel, error)
+	Set(InventoryLevelSetOptions) (*InventoryLevel, error)
+	SetWithContext(context.Context, InventoryLevelSetOptions) (*InventoryLevel, error)
+	Connect(InventoryLevelConnectOptions) (*InventoryLevel, error)
+	ConnectWithContext(context.Context, InventoryLevelConnectOptions) (*InventoryLevel, error)
+	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
+}
+
+// InventoryLevelAPIOp handles communication with the inventory level related
+// methods of the Shopify API.
+type InventoryLevelAPIOp struct {
+	client *Client
+}
+
+// InventoryLevelListOptions a struct for all available inventory level list
+// options. Shopify requires InventoryItemIDs or LocationIDs to be set.
+type InventoryLevelListOptions struct {
+	InventoryItemIDs []int      `url:"inventory_item_ids,omitempty,comma"`
+	LocationIDs      []int      `url:"location_ids,omitempty,comma"`
+	Limit            int        `url:"limit,omitempty"`
+	PageInfo         string     `url:"page_info,omitempty"`
+	UpdatedAtMin     *time.Time `url:"updated_at_min,omitempty"`
+}
+
+// InventoryLevel represents the stock of an inventory item at a location.
+// Available is nil if the inventory item is not tracked.
+type InventoryLevel struct {
+	InventoryItemID int        `json:"inventory_item_id,omitempty"`
+	LocationID      int        `json:"location_id,omitempty"`
+	Available       *int       `json:"available"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// InventoryLevelAdjustOptions adjusts the available stock of an inventory
+// item at a location by AvailableAdjustment, which may be negative.
+type InventoryLevelAdjustOptions struct {
+	InventoryItemID     int `json:"inventory_item_id"`
+	LocationID          int `json:"location_id"`
+	AvailableAdjustment int `json:"available_adjustment"`
+}
+
+// InventoryLevelSetOptions sets the available stock of an inventory item at a
+// location. DisconnectIfNecessary disconnects the item from fulfillment
+// service locations that would otherwise conflict with the location.
+type InventoryLevelSetOptions struct {
+	InventoryItemID       int  `json:"inventory_item_id"`
+	LocationID            int  `json:"location_id"`
+	Available             int  `json:"available"`
+	DisconnectIfNecessary bool `json:"disconnect_if_necessary,omitempty"`
+}
+
+// InventoryLevelConnectOptions connects an inventory item to a location.
+// RelocateIfNecessary moves the item away from a conflicting fulfillment
+// service location.
+type InventoryLevelConnectOptions struct {
+	InventoryItemID     int  `json:"inventory_item_id"`
+	LocationID          int  `json:"location_id"`
+	RelocateIfNecessary bool `json:"relocate_if_necessary,omitempty"`
+}
+
+// InventoryLevelResource represents the result from the
+// inventory_levels/adjust.json, set.json and connect.json endpoints
+type InventoryLevelResource struct {
+	InventoryLevel *InventoryLevel `json:"inventory_level"`
+}
+
+// InventoryLevelsResource represents the result from the
+// inventory_levels.json endpoint
+type InventoryLevelsResource struct {
+	InventoryLevels []InventoryLevel `json:"inventory_levels"`
+}
+
+// inventoryLevelDeleteOptions are the query options of the
+// inventory_levels.json endpoint when deleting an inventory level
+type inventoryLevelDeleteOptions struct {
+	InventoryItemID int `url:"inventory_item_id"`
+	LocationID      int `url:"location_id"`
+}
+
+// List inventory levels
+func (s *InventoryLevelAPIOp) List(options interface{}) ([]InventoryLevel, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *InventoryLevelAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]InventoryLevel, error) {
+	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelsResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.InventoryLevels, err
+}
+
+// ListWithPagination lists inventory levels and returns the pagination to request the
+// next and previous pages.
+func (s *InventoryLevelAPIOp) ListWithPagination(options interface{}) ([]InventoryLevel, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *InventoryLevelAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]InventoryLevel, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *InventoryLevelsResource) []InventoryLevel { return r.InventoryLevels })
+}
+
+// ListAll lists all inventory levels, following the pagination from the page
+// requested by options to the last page.
+func (s *InventoryLevelAPIOp) ListAll(options interface{}) ([]InventoryLevel, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *InventoryLevelAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]InventoryLevel, error) {
+	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *InventoryLevelsResource) []InventoryLevel { return r.InventoryLevels })
+}
+
+// Adjust the available stock of an inventory item at a location
+func (s *InventoryLevelAPIOp) Adjust(options InventoryLevelAdjustOptions) (*InventoryLevel, error) {
+	return s.AdjustWithContext(context.Background(), options)
+}
+
+// AdjustWithContext is the context-aware variant of Adjust.
+func (s *InventoryLevelAPIOp) AdjustWithContext(ctx context.Context, options InventoryLevelAdjustOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/adjust.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.PostWithContext(ctx, path, options, resource)
+	return resource.InventoryLevel, err
+}
+
+// Set the available stock of an inventory item at a location
+func (s *InventoryLevelAPIOp) Set(options InventoryLevelSetOptions) (*InventoryLevel, error) {
+	return s.SetWithContext(context.Background(), options)
+}
+
+// SetWithContext is the context-aware variant of Set.
+func (s *InventoryLevelAPIOp) SetWithContext(ctx context.Context, options InventoryLevelSetOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/set.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.PostWithContext(ctx, path, options, resource)
+	return resource.InventoryLevel, err
+}
+
+// Connect an inventory item to a location
+func (s *InventoryLevelAPIOp) Connect(options InventoryLevelConnectOptions) (*InventoryLevel, error) {
+	return s.ConnectWithContext(context.Background(), options)
+}
+
+// ConnectWithContext is the context-aware variant of Connect.
+func (s *InventoryLevelAPIOp) ConnectWithContext(ctx context.Context, options InventoryLevelConnectOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/connect.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.PostWithContext(ctx, path, options, resource)
+	return resource.InventoryLevel, err
+}
+
+// Delete the inventory level of an inventory item at a location, which
+// disconnects the item from the location
+func (s *InventoryLevelAPIOp) Delete(inventoryItemID int, locationID int) error {
+	return s.DeleteWithContext(context.Background(), inventoryItemID, locationID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *InventoryLevelAPIOp) DeleteWithContext(ctx context.Context, inventoryItemID int, locationID int) error {
+	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
+	options := inventoryLevelDeleteOptions{InventoryItemID: inventoryItemID, LocationID: locationID}
+	return s.client.CreateAndDoWithContext(ctx, "DELETE", path, nil, options, nil)
+}
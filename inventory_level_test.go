@@ -0,0 +1,133 @@
+package goshopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+// inventoryLevelResponder checks the body posted to an inventory level
+// endpoint and responds with the inventory level fixture.
+func inventoryLevelResponder(t *testing.T, expected string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != expected {
+			t.Errorf("%s sent body %s, expected %s", req.URL.Path, body, expected)
+		}
+		return httpmock.NewBytesResponse(200, loadFixture("inventory_level.json")), nil
+	}
+}
+
+func inventoryLevelTests(t *testing.T, level InventoryLevel) {
+	if level.InventoryItemID != 808950810 {
+		t.Errorf("InventoryLevel.InventoryItemID returned %d, expected %d", level.InventoryItemID, 808950810)
+	}
+	if level.LocationID != 905684977 {
+		t.Errorf("InventoryLevel.LocationID returned %d, expected %d", level.LocationID, 905684977)
+	}
+	if level.Available == nil || *level.Available != 6 {
+		t.Errorf("InventoryLevel.Available returned %v, expected 6", level.Available)
+	}
+}
+
+func TestInventoryLevelList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"location_ids": "905684977"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		"https://fooshop.myshopify.com/admin/inventory_levels.json",
+		params,
+		httpmock.NewBytesResponder(200, loadFixture("inventory_levels.json")))
+
+	levels, err := client.InventoryLevel.List(InventoryLevelListOptions{LocationIDs: []int{905684977}})
+	if err != nil {
+		t.Fatalf("InventoryLevel.List returned error: %v", err)
+	}
+
+	if len(levels) != 2 {
+		t.Fatalf("InventoryLevel.List returned %d levels, expected 2", len(levels))
+	}
+	if levels[0].Available == nil || *levels[0].Available != 1 {
+		t.Errorf("InventoryLevel.List returned Available %v, expected 1", levels[0].Available)
+	}
+	if levels[1].Available != nil {
+		t.Errorf("InventoryLevel.List returned Available %v for an untracked item, expected nil", *levels[1].Available)
+	}
+}
+
+func TestInventoryLevelAdjust(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/inventory_levels/adjust.json",
+		inventoryLevelResponder(t, `{"inventory_item_id":808950810,"location_id":905684977,"available_adjustment":-2}`))
+
+	level, err := client.InventoryLevel.Adjust(InventoryLevelAdjustOptions{
+		InventoryItemID:     808950810,
+		LocationID:          905684977,
+		AvailableAdjustment: -2,
+	})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Adjust returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, *level)
+}
+
+func TestInventoryLevelSet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/inventory_levels/set.json",
+		inventoryLevelResponder(t, `{"inventory_item_id":808950810,"location_id":905684977,"available":0}`))
+
+	level, err := client.InventoryLevel.Set(InventoryLevelSetOptions{
+		InventoryItemID: 808950810,
+		LocationID:      905684977,
+	})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Set returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, *level)
+}
+
+func TestInventoryLevelConnect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/inventory_levels/connect.json",
+		inventoryLevelResponder(t, `{"inventory_item_id":808950810,"location_id":905684977,"relocate_if_necessary":true}`))
+
+	level, err := client.InventoryLevel.Connect(InventoryLevelConnectOptions{
+		InventoryItemID:     808950810,
+		LocationID:          905684977,
+		RelocateIfNecessary: true,
+	})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Connect returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, *level)
+}
+
+func TestInventoryLevelDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"inventory_item_id": "808950810", "location_id": "905684977"}
+	httpmock.RegisterResponderWithQuery(
+		"DELETE",
+		"https://fooshop.myshopify.com/admin/inventory_levels.json",
+		params,
+		httpmock.NewStringResponder(204, ""))
+
+	err := client.InventoryLevel.Delete(808950810, 905684977)
+	if err != nil {
+		t.Errorf("InventoryLevel.Delete returned error: %v", err)
+	}
+}
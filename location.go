@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -13,10 +14,13 @@ const locationsBasePath = "admin/locations"
 type LocationAPI interface {
 	// Retrieves a list of locations
 	List(options interface{}) ([]Location, error)
+	ListWithContext(ctx context.Context, options interface{}) ([]Location, error)
 	// Retrieves a single location by its ID
 	Get(ID int, options interface{}) (*Location, error)
+	GetWithContext(ctx context.Context, ID int, options interface{}) (*Location, error)
 	// Retrieves a count of locations
 	Count(options interface{}) (int, error)
+	CountWithContext(ctx context.Context, options interface{}) (int, error)
 }
 
 // LocationAPIOp handles communication with the location related methods of
@@ -93,22 +97,37 @@ type LocationsResource struct {
 
 // List locations
 func (s *LocationAPIOp) List(options interface{}) ([]Location, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *LocationAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Location, error) {
 	path := fmt.Sprintf("%s.json", locationsBasePath)
 	resource := new(LocationsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Locations, err
 }
 
 // Get location
 func (s *LocationAPIOp) Get(ID int, options interface{}) (*Location, error) {
+	return s.GetWithContext(context.Background(), ID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *LocationAPIOp) GetWithContext(ctx context.Context, ID int, options interface{}) (*Location, error) {
 	path := fmt.Sprintf("%s/%d.json", locationsBasePath, ID)
 	resource := new(LocationResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Location, err
 }
 
 // Count locations
 func (s *LocationAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *LocationAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", locationsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
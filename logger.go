@@ -0,0 +1,122 @@
+package goshopify
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Logger receives log records from a Client. The arguments following the
+// message are alternating keys and values, as in the log/slog handler
+// model, so a *slog.Logger can be used as it is:
+//
+//	client, err := goshopify.NewClient(app, "shopname", "token",
+//		goshopify.WithLogger(slog.Default()))
+//
+// Every call is logged with its method, path, status, duration, call limit
+// and request ID. Successful calls are logged at Info level, client errors
+// at Warn level and server and connection errors at Error level.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// requestIDHeader identifies a request in Shopify's logs, e.g. for support.
+const requestIDHeader = "X-Request-Id"
+
+// redactedValue replaces tokens and personal data in debug dumps.
+const redactedValue = "[REDACTED]"
+
+// logCall logs an attempt of req that received resp or failed with err.
+func (c *Client) logCall(req *http.Request, resp *http.Response, duration time.Duration, err error) {
+	args := []interface{}{
+		"method", req.Method,
+		"path", req.URL.Path,
+		"duration", duration,
+	}
+	if err != nil {
+		c.Logger.Error("shopify: request failed", append(args, "error", err)...)
+		return
+	}
+
+	args = append(args,
+		"status", resp.StatusCode,
+		"call_limit", resp.Header.Get(callLimitHeader),
+		"request_id", resp.Header.Get(requestIDHeader),
+	)
+	switch {
+	case resp.StatusCode >= 500:
+		c.Logger.Error("shopify: request", args...)
+	case resp.StatusCode >= 400:
+		c.Logger.Warn("shopify: request", args...)
+	default:
+		c.Logger.Info("shopify: request", args...)
+	}
+}
+
+// logDump logs the headers and bodies of req and resp at Debug level, with
+// tokens and personal data redacted.
+func (c *Client) logDump(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) {
+	c.Logger.Debug("shopify: dump",
+		"method", req.Method,
+		"path", req.URL.Path,
+		"request_header", RedactHeader(req.Header, redactedValue),
+		"request_body", redactLogBody(reqBody),
+		"status", resp.StatusCode,
+		"response_header", RedactHeader(resp.Header, redactedValue),
+		"response_body", redactLogBody(respBody),
+	)
+}
+
+// dumpRequestBody returns the body of req without consuming it.
+func dumpRequestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	b, _ := ioutil.ReadAll(body)
+	return b
+}
+
+// dumpResponseBody reads the body of resp and replaces it, so that it can be
+// read again.
+func dumpResponseBody(resp *http.Response) ([]byte, error) {
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// redactLogBody returns a JSON body with tokens and personal data redacted.
+// Bodies that are not JSON are replaced as a whole, since they cannot be
+// redacted.
+func redactLogBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	redacted, err := RedactJSON(body, RedactedFields, redactLogValue)
+	if err != nil {
+		return redactedValue
+	}
+	return string(redacted)
+}
+
+// redactLogValue replaces a redacted value in debug dumps. Missing values
+// are kept, since they do not leak anything.
+func redactLogValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return redactedValue
+}
@@ -0,0 +1,223 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+// logRecord is a record received by a testLogger.
+type logRecord struct {
+	level string
+	msg   string
+	attrs map[string]interface{}
+}
+
+// testLogger is a Logger keeping the records it receives.
+type testLogger struct {
+	mu      sync.Mutex
+	records []logRecord
+}
+
+func (l *testLogger) log(level, msg string, args []interface{}) {
+	attrs := make(map[string]interface{})
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[fmt.Sprint(args[i])] = args[i+1]
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records = append(l.records, logRecord{level: level, msg: msg, attrs: attrs})
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("DEBUG", msg, args) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("INFO", msg, args) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("WARN", msg, args) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("ERROR", msg, args) }
+
+// find returns the first record with the message.
+func (l *testLogger) find(msg string) *logRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range l.records {
+		if l.records[i].msg == msg {
+			return &l.records[i]
+		}
+	}
+	return nil
+}
+
+func TestLoggerCall(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logger := &testLogger{}
+	client.Logger = logger
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/shop.json",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"shop":{"id":1}}`)
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "3/40")
+			resp.Header.Set("X-Request-Id", "abc-123")
+			return resp, nil
+		})
+
+	if _, err := client.Shop.Get(nil); err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+
+	record := logger.find("shopify: request")
+	if record == nil {
+		t.Fatalf("Logger received %+v, expected a request record", logger.records)
+	}
+
+	expected := map[string]interface{}{
+		"method":     "GET",
+		"path":       "/admin/shop.json",
+		"status":     200,
+		"call_limit": "3/40",
+		"request_id": "abc-123",
+	}
+	if record.level != "INFO" {
+		t.Errorf("Request record level = %s, expected INFO", record.level)
+	}
+	for key, value := range expected {
+		if record.attrs[key] != value {
+			t.Errorf("Request record %s = %v, expected %v", key, record.attrs[key], value)
+		}
+	}
+	if _, ok := record.attrs["duration"].(time.Duration); !ok {
+		t.Errorf("Request record duration = %v, expected a time.Duration", record.attrs["duration"])
+	}
+	if logger.find("shopify: dump") != nil {
+		t.Errorf("Logger received a dump without DebugDump")
+	}
+}
+
+func TestLoggerLevels(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		status int
+		level  string
+	}{
+		{200, "INFO"},
+		{404, "WARN"},
+		{429, "WARN"},
+		{503, "ERROR"},
+	}
+
+	for _, c := range cases {
+		logger := &testLogger{}
+		client.Logger = logger
+
+		httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo",
+			httpmock.NewStringResponder(c.status, `{}`))
+		client.Get("foo", nil, nil)
+
+		record := logger.find("shopify: request")
+		if record == nil || record.level != c.level {
+			t.Errorf("Logger received %+v for status %d, expected a %s record", logger.records, c.status, c.level)
+		}
+	}
+}
+
+func TestLoggerConnectionError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logger := &testLogger{}
+	client.Logger = logger
+
+	if err := client.Get("foo", nil, nil); err == nil {
+		t.Fatalf("Client.Get without a responder returned nil error")
+	}
+
+	record := logger.find("shopify: request failed")
+	if record == nil || record.level != "ERROR" || record.attrs["error"] == nil {
+		t.Errorf("Logger received %+v, expected an error record", logger.records)
+	}
+}
+
+func TestLoggerDebugDump(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logger := &testLogger{}
+	client.Logger = logger
+	client.DebugDump = true
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/customers.json",
+		httpmock.NewBytesResponder(200, loadFixture("customer.json")))
+
+	customer, err := client.Customer.Create(Customer{
+		Email:     "bob.norman@hostmail.com",
+		FirstName: "Bob",
+		Addresses: []*CustomerAddress{{Address1: "Chestnut Street 92", Name: "Bob Norman", City: "Louisville"}},
+	})
+	if err != nil {
+		t.Fatalf("Customer.Create returned error: %v", err)
+	}
+	if customer.Email != "test@example.com" {
+		t.Errorf("Customer.Create returned email %s, expected the decoded response after the dump", customer.Email)
+	}
+
+	record := logger.find("shopify: dump")
+	if record == nil {
+		t.Fatalf("Logger received %+v, expected a dump record", logger.records)
+	}
+	if record.level != "DEBUG" {
+		t.Errorf("Dump record level = %s, expected DEBUG", record.level)
+	}
+
+	header, _ := record.attrs["request_header"].(http.Header)
+	if token := header.Get("X-Shopify-Access-Token"); token != redactedValue {
+		t.Errorf("Dumped access token = %q, expected it to be redacted", token)
+	}
+
+	cases := []struct {
+		key  string
+		pii  []string
+		kept string
+	}{
+		{"request_body", []string{"bob.norman@hostmail.com", "Bob Norman", "Chestnut Street"}, "Louisville"},
+		{"response_body", []string{"test@example.com", "Test Citizen", "1 Smith St", "1111 111 111"}, "BRISBANE"},
+	}
+	for _, c := range cases {
+		body, _ := record.attrs[c.key].(string)
+		for _, pii := range c.pii {
+			if strings.Contains(body, pii) {
+				t.Errorf("Dump record %s contains %q: %s", c.key, pii, body)
+			}
+		}
+		if !strings.Contains(body, c.kept) {
+			t.Errorf("Dump record %s is missing %s: %s", c.key, c.kept, body)
+		}
+	}
+}
+
+func TestRedactLogBody(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected string
+	}{
+		{``, ``},
+		{`{"access_token":"secret","scope":"read_orders"}`, `{"access_token":"[REDACTED]","scope":"read_orders"}`},
+		{`{"order":{"name":"#1001","billing_address":{"name":"Bob","zip":"40202","latitude":45.41}}}`, `{"order":{"billing_address":{"latitude":"[REDACTED]","name":"[REDACTED]","zip":"[REDACTED]"},"name":"#1001"}}`},
+		{`{"order":{"browser_ip":"216.191.105.146","contact_email":"bob@example.com","total_price":"10.00"}}`, `{"order":{"browser_ip":"[REDACTED]","contact_email":"[REDACTED]","total_price":"10.00"}}`},
+		{`{"customer":{"email":null,"total_spent":"199.65"}}`, `{"customer":{"email":null,"total_spent":"199.65"}}`},
+		{`not json`, `[REDACTED]`},
+	}
+
+	for _, c := range cases {
+		if actual := redactLogBody([]byte(c.body)); actual != c.expected {
+			t.Errorf("redactLogBody(%s) = %s, expected %s", c.body, actual, c.expected)
+		}
+	}
+}
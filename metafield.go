@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -10,11 +11,21 @@ import (
 // https://help.shopify.com/api/reference/metafield
 type MetafieldAPI interface {
 	List(interface{}) ([]Metafield, error)
+	ListWithContext(context.Context, interface{}) ([]Metafield, error)
+	ListWithPagination(interface{}) ([]Metafield, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Metafield, *Pagination, error)
+	ListAll(interface{}) ([]Metafield, error)
+	ListAllWithContext(context.Context, interface{}) ([]Metafield, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Metafield, error)
+	GetWithContext(context.Context, int, interface{}) (*Metafield, error)
 	Create(Metafield) (*Metafield, error)
+	CreateWithContext(context.Context, Metafield) (*Metafield, error)
 	Update(Metafield) (*Metafield, error)
+	UpdateWithContext(context.Context, Metafield) (*Metafield, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 }
 
 // MetafieldsAPI is an interface for other Shopify resources
@@ -22,11 +33,17 @@ type MetafieldAPI interface {
 // https://help.shopify.com/api/reference/metafield
 type MetafieldsAPI interface {
 	ListMetafields(int, interface{}) ([]Metafield, error)
+	ListMetafieldsWithContext(context.Context, int, interface{}) ([]Metafield, error)
 	CountMetafields(int, interface{}) (int, error)
+	CountMetafieldsWithContext(context.Context, int, interface{}) (int, error)
 	GetMetafield(int, int, interface{}) (*Metafield, error)
+	GetMetafieldWithContext(context.Context, int, int, interface{}) (*Metafield, error)
 	CreateMetafield(int, Metafield) (*Metafield, error)
+	CreateMetafieldWithContext(context.Context, int, Metafield) (*Metafield, error)
 	UpdateMetafield(int, Metafield) (*Metafield, error)
+	UpdateMetafieldWithContext(context.Context, int, Metafield) (*Metafield, error)
 	DeleteMetafield(int, int) error
+	DeleteMetafieldWithContext(context.Context, int, int) error
 }
 
 // MetafieldAPIOp handles communication with the metafield
@@ -63,51 +80,107 @@ type MetafieldsResource struct {
 
 // List metafields
 func (s *MetafieldAPIOp) List(options interface{}) ([]Metafield, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *MetafieldAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Metafield, error) {
 	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s.json", prefix)
 	resource := new(MetafieldsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Metafields, err
 }
 
+// ListWithPagination lists metafields and returns the pagination to request the
+// next and previous pages.
+func (s *MetafieldAPIOp) ListWithPagination(options interface{}) ([]Metafield, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *MetafieldAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Metafield, *Pagination, error) {
+	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
+	path := fmt.Sprintf("%s.json", prefix)
+	return listPage(ctx, s.client, path, options, func(r *MetafieldsResource) []Metafield { return r.Metafields })
+}
+
+// ListAll lists all metafields, following the pagination from the page
+// requested by options to the last page.
+func (s *MetafieldAPIOp) ListAll(options interface{}) ([]Metafield, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *MetafieldAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Metafield, error) {
+	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
+	path := fmt.Sprintf("%s.json", prefix)
+	return listAll(ctx, s.client, path, options, func(r *MetafieldsResource) []Metafield { return r.Metafields })
+}
+
 // Count metafields
 func (s *MetafieldAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *MetafieldAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/count.json", prefix)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual metafield
 func (s *MetafieldAPIOp) Get(metafieldID int, options interface{}) (*Metafield, error) {
+	return s.GetWithContext(context.Background(), metafieldID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *MetafieldAPIOp) GetWithContext(ctx context.Context, metafieldID int, options interface{}) (*Metafield, error) {
 	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d.json", prefix, metafieldID)
 	resource := new(MetafieldResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Metafield, err
 }
 
 // Create a new metafield
 func (s *MetafieldAPIOp) Create(metafield Metafield) (*Metafield, error) {
+	return s.CreateWithContext(context.Background(), metafield)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *MetafieldAPIOp) CreateWithContext(ctx context.Context, metafield Metafield) (*Metafield, error) {
 	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s.json", prefix)
 	wrappedData := MetafieldResource{Metafield: &metafield}
 	resource := new(MetafieldResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Metafield, err
 }
 
 // Update an existing metafield
 func (s *MetafieldAPIOp) Update(metafield Metafield) (*Metafield, error) {
+	return s.UpdateWithContext(context.Background(), metafield)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *MetafieldAPIOp) UpdateWithContext(ctx context.Context, metafield Metafield) (*Metafield, error) {
 	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d.json", prefix, metafield.ID)
 	wrappedData := MetafieldResource{Metafield: &metafield}
 	resource := new(MetafieldResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Metafield, err
 }
 
 // Delete an existing metafield
 func (s *MetafieldAPIOp) Delete(metafieldID int) error {
+	return s.DeleteWithContext(context.Background(), metafieldID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *MetafieldAPIOp) DeleteWithContext(ctx context.Context, metafieldID int) error {
 	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", prefix, metafieldID))
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", prefix, metafieldID))
 }
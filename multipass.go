@@ -0,0 +1,105 @@
+package goshopify
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Multipass generates multipass tokens logging customers into a Shopify Plus
+// shop's storefront with their account on another site.
+// See: https://help.shopify.com/en/api/reference/plus/multipass
+type Multipass struct {
+	encryptionKey []byte
+	signingKey    []byte
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+}
+
+// MultipassCustomer is the customer data encoded in a multipass token. The
+// customer is identified by Email, or by Identifier if set.
+type MultipassCustomer struct {
+	Email      string            `json:"email"`
+	CreatedAt  *time.Time        `json:"created_at,omitempty"`
+	FirstName  string            `json:"first_name,omitempty"`
+	LastName   string            `json:"last_name,omitempty"`
+	Tags       string            `json:"tag_string,omitempty"`
+	Identifier string            `json:"identifier,omitempty"`
+	RemoteIP   string            `json:"remote_ip,omitempty"`
+	ReturnTo   string            `json:"return_to,omitempty"`
+	Addresses  []CustomerAddress `json:"addresses,omitempty"`
+}
+
+// NewMultipass returns a Multipass using the multipass secret of a shop.
+func NewMultipass(secret string) *Multipass {
+	key := sha256.Sum256([]byte(secret))
+	return &Multipass{
+		encryptionKey: key[:16],
+		signingKey:    key[16:],
+		now:           time.Now,
+	}
+}
+
+// Token returns the multipass token for customer. CreatedAt defaults to the
+// current time, tokens are only accepted for a few minutes after it.
+func (m *Multipass) Token(customer MultipassCustomer) (string, error) {
+	if customer.Email == "" {
+		return "", errors.New("multipass customer has no email")
+	}
+	if customer.CreatedAt == nil {
+		now := m.now()
+		customer.CreatedAt = &now
+	}
+
+	data, err := json.Marshal(customer)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := m.encrypt(data)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, m.signingKey)
+	mac.Write(ciphertext)
+	return base64.URLEncoding.EncodeToString(mac.Sum(ciphertext)), nil
+}
+
+// LoginURL returns the url logging customer into the storefront of shopName.
+func (m *Multipass) LoginURL(shopName string, customer MultipassCustomer) (string, error) {
+	token, err := m.Token(customer)
+	if err != nil {
+		return "", err
+	}
+	return ShopBaseURL(shopName) + "/account/login/multipass/" + token, nil
+}
+
+// encrypt encrypts data with AES-128-CBC and a random IV, which is prepended
+// to the ciphertext.
+func (m *Multipass) encrypt(data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(m.encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// PKCS#7 padding
+	padding := aes.BlockSize - len(data)%aes.BlockSize
+	data = append(data, bytes.Repeat([]byte{byte(padding)}, padding)...)
+
+	ciphertext := make([]byte, aes.BlockSize+len(data))
+	iv := ciphertext[:aes.BlockSize]
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext[aes.BlockSize:], data)
+	return ciphertext, nil
+}
@@ -0,0 +1,122 @@
+package goshopify
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// decodeMultipassToken verifies and decrypts a multipass token the way
+// Shopify does.
+func decodeMultipassToken(t *testing.T, secret, token string) map[string]interface{} {
+	key := sha256.Sum256([]byte(secret))
+
+	raw, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatalf("multipass token is not URL-safe base64: %v", err)
+	}
+	ciphertext, signature := raw[:len(raw)-sha256.Size], raw[len(raw)-sha256.Size:]
+
+	mac := hmac.New(sha256.New, key[16:])
+	mac.Write(ciphertext)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		t.Fatalf("multipass token signature is invalid")
+	}
+
+	block, _ := aes.NewCipher(key[:16])
+	iv, data := ciphertext[:aes.BlockSize], ciphertext[aes.BlockSize:]
+	if len(data)%aes.BlockSize != 0 {
+		t.Fatalf("multipass ciphertext is not a multiple of the block size")
+	}
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(data, data)
+	data = data[:len(data)-int(data[len(data)-1])]
+
+	var customer map[string]interface{}
+	if err := json.Unmarshal(data, &customer); err != nil {
+		t.Fatalf("multipass token does not contain JSON: %v", err)
+	}
+	return customer
+}
+
+func TestMultipassToken(t *testing.T) {
+	m := NewMultipass("multipass secret")
+	m.now = func() time.Time { return time.Date(2019, time.May, 1, 12, 0, 0, 0, time.UTC) }
+
+	token, err := m.Token(MultipassCustomer{
+		Email:    "bob@shopify.com",
+		Tags:     "canadian,premium",
+		ReturnTo: "https://fooshop.myshopify.com/cart",
+		Addresses: []CustomerAddress{
+			{Address1: "123 Oak St", City: "Ottawa", Country: "Canada", Default: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Multipass.Token returned error: %v", err)
+	}
+
+	customer := decodeMultipassToken(t, "multipass secret", token)
+
+	expected := map[string]string{
+		"email":      "bob@shopify.com",
+		"created_at": "2019-05-01T12:00:00Z",
+		"tag_string": "canadian,premium",
+		"return_to":  "https://fooshop.myshopify.com/cart",
+	}
+	for key, value := range expected {
+		if customer[key] != value {
+			t.Errorf("Multipass token has %s %v, expected %v", key, customer[key], value)
+		}
+	}
+
+	addresses, _ := customer["addresses"].([]interface{})
+	if len(addresses) != 1 {
+		t.Fatalf("Multipass token has addresses %v, expected 1", customer["addresses"])
+	}
+	address := addresses[0].(map[string]interface{})
+	if address["city"] != "Ottawa" || address["default"] != true {
+		t.Errorf("Multipass token has address %v, expected the Ottawa default address", address)
+	}
+}
+
+func TestMultipassTokenRandomIV(t *testing.T) {
+	m := NewMultipass("multipass secret")
+	customer := MultipassCustomer{Email: "bob@shopify.com"}
+
+	first, _ := m.Token(customer)
+	second, _ := m.Token(customer)
+	if first == second {
+		t.Errorf("Multipass.Token returned the same token twice")
+	}
+}
+
+func TestMultipassTokenWithoutEmail(t *testing.T) {
+	m := NewMultipass("multipass secret")
+	if _, err := m.Token(MultipassCustomer{FirstName: "Bob"}); err == nil {
+		t.Errorf("Multipass.Token without email returned nil error")
+	}
+}
+
+func TestMultipassLoginURL(t *testing.T) {
+	m := NewMultipass("multipass secret")
+
+	loginURL, err := m.LoginURL("fooshop", MultipassCustomer{Email: "bob@shopify.com"})
+	if err != nil {
+		t.Fatalf("Multipass.LoginURL returned error: %v", err)
+	}
+
+	prefix := "https://fooshop.myshopify.com/account/login/multipass/"
+	if !strings.HasPrefix(loginURL, prefix) {
+		t.Fatalf("Multipass.LoginURL returned %s, expected prefix %s", loginURL, prefix)
+	}
+
+	customer := decodeMultipassToken(t, "multipass secret", strings.TrimPrefix(loginURL, prefix))
+	if customer["email"] != "bob@shopify.com" {
+		t.Errorf("Multipass.LoginURL token has email %v, expected bob@shopify.com", customer["email"])
+	}
+}
@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
@@ -20,23 +21,92 @@ const shopifyChecksumHeader = "X-Shopify-Hmac-Sha256"
 // State is a unique value that can be used to check the authenticity during a
 // callback from Shopify.
 func (app App) AuthorizeURL(shopName string, state string) string {
+	return app.AuthorizeURLWithOptions(shopName, state, AuthorizeOptions{})
+}
+
+// AuthorizeOptions can be used to request an online access token or other
+// scopes than the app's in an authorization url.
+type AuthorizeOptions struct {
+	// Online requests an online access token, which is bound to the staff
+	// member authorizing the app and expires with their session.
+	Online bool
+
+	// Scope overrides the app's Scope if set.
+	Scope string
+}
+
+// AuthorizeURLWithOptions returns a Shopify oauth authorization url for the
+// given shopname and state like AuthorizeURL, customized by options.
+func (app App) AuthorizeURLWithOptions(shopName string, state string, options AuthorizeOptions) string {
+	scope := app.Scope
+	if options.Scope != "" {
+		scope = options.Scope
+	}
+
 	shopURL, _ := url.Parse(ShopBaseURL(shopName))
 	shopURL.Path = "/admin/oauth/authorize"
 	query := shopURL.Query()
 	query.Set("client_id", app.APIKey)
 	query.Set("redirect_uri", app.RedirectURL)
-	query.Set("scope", app.Scope)
+	query.Set("scope", scope)
 	query.Set("state", state)
+	if options.Online {
+		query.Set("grant_options[]", "per-user")
+	}
 	shopURL.RawQuery = query.Encode()
 	return shopURL.String()
 }
 
-// GetAccessToken get access token
-func (app App) GetAccessToken(shopName string, code string) (string, error) {
-	type Token struct {
-		Token string `json:"access_token"`
+// GetAccessToken get access token. The options configure the client
+// exchanging the code, e.g. WithHTTPClient.
+func (app App) GetAccessToken(shopName string, code string, opts ...Option) (string, error) {
+	token, err := app.GetAccessTokenResponse(shopName, code, opts...)
+	if err != nil {
+		return "", err
 	}
+	return token.AccessToken, nil
+}
+
+// AccessTokenResponse is the response of the access token endpoint. Online
+// access tokens expire and carry the staff member that authorized the app.
+type AccessTokenResponse struct {
+	AccessToken         string          `json:"access_token"`
+	Scope               string          `json:"scope"`
+	ExpiresIn           int             `json:"expires_in,omitempty"`
+	AssociatedUserScope string          `json:"associated_user_scope,omitempty"`
+	AssociatedUser      *AssociatedUser `json:"associated_user,omitempty"`
+}
 
+// AssociatedUser is the staff member an online access token was issued to.
+type AssociatedUser struct {
+	ID            int    `json:"id"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	AccountOwner  bool   `json:"account_owner"`
+	Locale        string `json:"locale"`
+	Collaborator  bool   `json:"collaborator"`
+}
+
+// Scopes returns the scopes granted to the app.
+func (r AccessTokenResponse) Scopes() Scopes {
+	return ParseScopes(r.Scope)
+}
+
+// Online reports whether the token is an online access token.
+func (r AccessTokenResponse) Online() bool {
+	return r.AssociatedUser != nil
+}
+
+// GetAccessTokenResponse exchanges the authorization code of the shop for an
+// access token like GetAccessToken, returning the whole response.
+func (app App) GetAccessTokenResponse(shopName string, code string, opts ...Option) (*AccessTokenResponse, error) {
+	return app.GetAccessTokenResponseWithContext(context.Background(), shopName, code, opts...)
+}
+
+// GetAccessTokenResponseWithContext is the context-aware variant of GetAccessTokenResponse.
+func (app App) GetAccessTokenResponseWithContext(ctx context.Context, shopName string, code string, opts ...Option) (*AccessTokenResponse, error) {
 	data := struct {
 		ClientID     string `json:"client_id"`
 		ClientSecret string `json:"client_secret"`
@@ -47,12 +117,18 @@ func (app App) GetAccessToken(shopName string, code string) (string, error) {
 		Code:         code,
 	}
 
-	client := NewClient(app, shopName, "")
-	req, err := client.NewRequest("POST", "admin/oauth/access_token", data, nil)
+	client, err := NewClient(app, shopName, "", opts...)
+	if err != nil {
+		return nil, err
+	}
+	token := new(AccessTokenResponse)
+	req, err := client.NewRequestWithContext(ctx, "POST", "admin/oauth/access_token", data, nil)
+	if err != nil {
+		return token, err
+	}
 
-	token := new(Token)
 	err = client.Do(req, token)
-	return token.Token, err
+	return token, err
 }
 
 // VerifyMessage verify a message against a message HMAC
@@ -0,0 +1,323 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultOAuthStateCookie = "shopify_oauth_state"
+	defaultOAuthStateMaxAge = 10 * time.Minute
+)
+
+var (
+	// ErrInvalidShop is returned for a shop that is not a myshopify.com
+	// domain, e.g. "fooshop.myshopify.com".
+	ErrInvalidShop = errors.New("invalid shop domain")
+
+	// ErrOAuthInvalidHMAC is returned for an install or callback request
+	// whose HMAC is invalid.
+	ErrOAuthInvalidHMAC = errors.New("invalid oauth HMAC")
+
+	// ErrOAuthInvalidState is returned for a callback request whose state
+	// was not issued for the shop by the install request, or has expired.
+	ErrOAuthInvalidState = errors.New("invalid oauth state")
+
+	// ErrOAuthMissingCode is returned for a callback request without an
+	// authorization code.
+	ErrOAuthMissingCode = errors.New("missing oauth code")
+
+	// ErrOAuthEmptySecret is returned for a state store without a secret,
+	// whose states could be forged.
+	ErrOAuthEmptySecret = errors.New("oauth state secret is empty")
+)
+
+// StateStore keeps the state nonces of OAuth flows between the install and
+// the callback request. Implementations must be safe for concurrent use.
+type StateStore interface {
+	// Save stores the state issued for shop by the install request.
+	Save(w http.ResponseWriter, r *http.Request, shop, state string) error
+
+	// Consume reports whether state was issued for shop and not yet
+	// consumed. A state can only be consumed once.
+	Consume(w http.ResponseWriter, r *http.Request, shop, state string) (bool, error)
+}
+
+// CookieStateStore is a StateStore keeping the state in a cookie of the
+// merchant's browser, signed with a secret so that it cannot be forged.
+type CookieStateStore struct {
+	secret []byte
+
+	// Name is the name of the cookie.
+	Name string
+
+	// MaxAge is the time the merchant has to authorize the app.
+	MaxAge time.Duration
+
+	// Secure restricts the cookie to HTTPS. Only disable it for local
+	// development.
+	Secure bool
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+}
+
+// NewCookieStateStore returns a CookieStateStore signing its cookies with
+// secret. The state expires after 10 minutes. ErrOAuthEmptySecret is
+// returned if secret is empty.
+func NewCookieStateStore(secret string) (*CookieStateStore, error) {
+	if secret == "" {
+		return nil, ErrOAuthEmptySecret
+	}
+	return &CookieStateStore{
+		secret: []byte(secret),
+		Name:   defaultOAuthStateCookie,
+		MaxAge: defaultOAuthStateMaxAge,
+		Secure: true,
+		now:    time.Now,
+	}, nil
+}
+
+// Save sets the signed state cookie.
+func (s *CookieStateStore) Save(w http.ResponseWriter, r *http.Request, shop, state string) error {
+	if len(s.secret) == 0 {
+		return ErrOAuthEmptySecret
+	}
+	expires := s.now().Add(s.MaxAge)
+	payload := strings.Join([]string{shop, state, strconv.FormatInt(expires.Unix(), 10)}, "|")
+	value := base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(s.sign(payload))
+
+	http.SetCookie(w, s.cookie(value, int(s.MaxAge/time.Second)))
+	return nil
+}
+
+// Consume verifies and clears the state cookie.
+func (s *CookieStateStore) Consume(w http.ResponseWriter, r *http.Request, shop, state string) (bool, error) {
+	if len(s.secret) == 0 {
+		return false, ErrOAuthEmptySecret
+	}
+	cookie, err := r.Cookie(s.Name)
+	if err != nil {
+		return false, nil
+	}
+	http.SetCookie(w, s.cookie("", -1))
+
+	parts := strings.Split(cookie.Value, ".")
+	if len(parts) != 2 {
+		return false, nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false, nil
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(mac, s.sign(string(payload))) {
+		return false, nil
+	}
+
+	fields := strings.Split(string(payload), "|")
+	if len(fields) != 3 {
+		return false, nil
+	}
+	expires, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil || s.now().Unix() > expires {
+		return false, nil
+	}
+	return fields[0] == shop && hmac.Equal([]byte(fields[1]), []byte(state)), nil
+}
+
+func (s *CookieStateStore) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func (s *CookieStateStore) cookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     s.Name,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   s.Secure,
+		HttpOnly: true,
+		// The callback is a top-level navigation from Shopify, Lax cookies
+		// are sent along.
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// OAuthHandler runs the OAuth flow installing the app in a shop. Its
+// InstallHandler redirects the merchant to Shopify to authorize the app, and
+// Shopify redirects back to its CallbackHandler, which must be served at the
+// app's RedirectURL.
+//
+// The handlers respond with
+//   - 400 Bad Request if the shop is not a myshopify.com domain or the code
+//     is missing,
+//   - 401 Unauthorized if the HMAC of the request is invalid,
+//   - 403 Forbidden if the state of the callback was not issued for the shop,
+//   - 500 Internal Server Error if the code cannot be exchanged or
+//     OnInstalled returns an error.
+type OAuthHandler struct {
+	app App
+
+	// States keeps the state nonces between the install and the callback
+	// request.
+	States StateStore
+
+	// OnInstalled is called with the shop, its access token and the scopes
+	// granted to the app, e.g. to store the token. Returning an error fails
+	// the callback.
+	OnInstalled func(shop, token string, scopes []string) error
+
+	// ReturnURL returns the URL the merchant is redirected to after the app
+	// is installed. It defaults to the app in the shop's admin.
+	ReturnURL func(shop string) string
+
+	// OnError is called with the errors of rejected and failed requests,
+	// e.g. to log them. It is optional.
+	OnError func(r *http.Request, err error)
+
+	// ClientOptions configure the client exchanging the code for an access
+	// token, e.g. WithHTTPClient or WithLogger. They are optional.
+	ClientOptions []Option
+}
+
+// NewOAuthHandler returns an OAuthHandler for app, keeping the state in a
+// cookie signed with the app's secret. ErrOAuthEmptySecret is returned if
+// the app has no secret.
+func NewOAuthHandler(app App) (*OAuthHandler, error) {
+	states, err := NewCookieStateStore(app.APISecret)
+	if err != nil {
+		return nil, err
+	}
+	return &OAuthHandler{
+		app:    app,
+		States: states,
+	}, nil
+}
+
+// NewOAuthHandler returns an OAuthHandler for the app.
+// a.NewOAuthHandler() is equivalent to NewOAuthHandler(a)
+func (app App) NewOAuthHandler() (*OAuthHandler, error) {
+	return NewOAuthHandler(app)
+}
+
+// InstallHandler returns the handler starting the OAuth flow for the shop in
+// the query, typically served at /install. The HMAC of the request is
+// verified if present, i.e. if the install was started from Shopify.
+func (h *OAuthHandler) InstallHandler() http.Handler {
+	return http.HandlerFunc(h.install)
+}
+
+// CallbackHandler returns the handler completing the OAuth flow, typically
+// served at /callback.
+func (h *OAuthHandler) CallbackHandler() http.Handler {
+	return http.HandlerFunc(h.callback)
+}
+
+func (h *OAuthHandler) install(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	shop := query.Get("shop")
+	if !validShopDomain(shop) {
+		h.fail(w, r, http.StatusBadRequest, ErrInvalidShop)
+		return
+	}
+
+	if query.Get("hmac") != "" {
+		if ok, _ := h.app.VerifyAuthorizationURL(r.URL); !ok {
+			h.fail(w, r, http.StatusUnauthorized, ErrOAuthInvalidHMAC)
+			return
+		}
+	}
+
+	state, err := newOAuthState()
+	if err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	if err := h.States.Save(w, r, shop, state); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	http.Redirect(w, r, h.app.AuthorizeURL(shop, state), http.StatusFound)
+}
+
+func (h *OAuthHandler) callback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	shop := query.Get("shop")
+	if !validShopDomain(shop) {
+		h.fail(w, r, http.StatusBadRequest, ErrInvalidShop)
+		return
+	}
+
+	if ok, _ := h.app.VerifyAuthorizationURL(r.URL); !ok {
+		h.fail(w, r, http.StatusUnauthorized, ErrOAuthInvalidHMAC)
+		return
+	}
+
+	ok, err := h.States.Consume(w, r, shop, query.Get("state"))
+	if err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	if !ok {
+		h.fail(w, r, http.StatusForbidden, ErrOAuthInvalidState)
+		return
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		h.fail(w, r, http.StatusBadRequest, ErrOAuthMissingCode)
+		return
+	}
+
+	token, err := h.app.GetAccessTokenResponseWithContext(r.Context(), shop, code, h.ClientOptions...)
+	if err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	if h.OnInstalled != nil {
+		if err := h.OnInstalled(shop, token.AccessToken, token.Scopes()); err != nil {
+			h.fail(w, r, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	http.Redirect(w, r, h.returnURL(shop), http.StatusFound)
+}
+
+func (h *OAuthHandler) returnURL(shop string) string {
+	if h.ReturnURL != nil {
+		return h.ReturnURL(shop)
+	}
+	return ShopBaseURL(shop) + "/admin/apps/" + h.app.APIKey
+}
+
+// fail reports err to OnError and responds with status.
+func (h *OAuthHandler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// newOAuthState returns a random state nonce.
+func newOAuthState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
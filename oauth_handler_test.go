@@ -0,0 +1,380 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+// signOAuthQuery adds the hmac Shopify signs redirects with to query.
+func signOAuthQuery(secret string, query url.Values) string {
+	message, _ := url.QueryUnescape(query.Encode())
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	query.Set("hmac", hex.EncodeToString(mac.Sum(nil)))
+	return query.Encode()
+}
+
+// mustNewOAuthHandler returns an OAuthHandler for app, panicking if it has
+// no secret.
+func mustNewOAuthHandler(app App) *OAuthHandler {
+	h, err := app.NewOAuthHandler()
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// startOAuth runs the install handler for shop and returns the state and
+// the state cookie it issued.
+func startOAuth(t *testing.T, h *OAuthHandler, shop string) (string, *http.Cookie) {
+	req := httptest.NewRequest("GET", "https://app.example.com/install?shop="+shop, nil)
+	w := httptest.NewRecorder()
+	h.InstallHandler().ServeHTTP(w, req)
+
+	if w.Code != http.StatusFound {
+		t.Fatalf("OAuthHandler install returned status %d, expected %d", w.Code, http.StatusFound)
+	}
+
+	location, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("OAuthHandler install redirected to invalid URL: %v", err)
+	}
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("OAuthHandler install set %d cookies, expected 1", len(cookies))
+	}
+	return location.Query().Get("state"), cookies[0]
+}
+
+// newOAuthCallback returns a signed callback request for shop.
+func newOAuthCallback(shop, state string, cookie *http.Cookie) *http.Request {
+	query := url.Values{}
+	query.Set("code", "foocode")
+	query.Set("shop", shop)
+	query.Set("state", state)
+	query.Set("timestamp", "1337178173")
+
+	req := httptest.NewRequest("GET", "https://example.com/callback?"+signOAuthQuery("hush", query), nil)
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	return req
+}
+
+func TestOAuthHandlerInstall(t *testing.T) {
+	setup()
+	defer teardown()
+
+	h := mustNewOAuthHandler(app)
+	state, cookie := startOAuth(t, h, "fooshop.myshopify.com")
+
+	if len(state) != 32 {
+		t.Errorf("OAuthHandler install issued state %q, expected 32 hex characters", state)
+	}
+	if cookie.Name != "shopify_oauth_state" || !cookie.HttpOnly || !cookie.Secure {
+		t.Errorf("OAuthHandler install set cookie %+v, expected a secure HTTP only shopify_oauth_state cookie", cookie)
+	}
+
+	other, _ := startOAuth(t, h, "fooshop.myshopify.com")
+	if other == state {
+		t.Errorf("OAuthHandler install issued state %q twice", state)
+	}
+}
+
+func TestOAuthHandlerInstallRedirect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req := httptest.NewRequest("GET", "https://app.example.com/install?shop=fooshop.myshopify.com", nil)
+	w := httptest.NewRecorder()
+	mustNewOAuthHandler(app).InstallHandler().ServeHTTP(w, req)
+
+	location, _ := url.Parse(w.Header().Get("Location"))
+	expected := app.AuthorizeURL("fooshop.myshopify.com", location.Query().Get("state"))
+	if location.String() != expected {
+		t.Errorf("OAuthHandler install redirected to %s, expected %s", location, expected)
+	}
+}
+
+func TestOAuthHandlerInstallRejected(t *testing.T) {
+	setup()
+	defer teardown()
+
+	signed := url.Values{}
+	signed.Set("shop", "fooshop.myshopify.com")
+	signed.Set("timestamp", "1337178173")
+	tampered := signOAuthQuery("hush", signed) + "&extra=1"
+
+	cases := []struct {
+		query    string
+		status   int
+		expected error
+	}{
+		{"", http.StatusBadRequest, ErrInvalidShop},
+		{"shop=fooshop", http.StatusBadRequest, ErrInvalidShop},
+		{"shop=evil.com", http.StatusBadRequest, ErrInvalidShop},
+		{"shop=evil.com%2F.myshopify.com", http.StatusBadRequest, ErrInvalidShop},
+		{tampered, http.StatusUnauthorized, ErrOAuthInvalidHMAC},
+	}
+
+	for _, c := range cases {
+		var reported error
+		h := mustNewOAuthHandler(app)
+		h.OnError = func(r *http.Request, err error) { reported = err }
+
+		req := httptest.NewRequest("GET", "https://app.example.com/install?"+c.query, nil)
+		w := httptest.NewRecorder()
+		h.InstallHandler().ServeHTTP(w, req)
+
+		if w.Code != c.status {
+			t.Errorf("OAuthHandler install with %q returned status %d, expected %d", c.query, w.Code, c.status)
+		}
+		if reported != c.expected {
+			t.Errorf("OAuthHandler install with %q reported %v, expected %v", c.query, reported, c.expected)
+		}
+	}
+}
+
+func TestOAuthHandlerCallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewStringResponder(200, `{"access_token":"footoken","scope":"read_products,write_orders"}`))
+
+	h := mustNewOAuthHandler(app)
+	var installedShop, installedToken string
+	var installedScopes []string
+	h.OnInstalled = func(shop, token string, scopes []string) error {
+		installedShop, installedToken, installedScopes = shop, token, scopes
+		return nil
+	}
+
+	state, cookie := startOAuth(t, h, "fooshop.myshopify.com")
+	w := httptest.NewRecorder()
+	h.CallbackHandler().ServeHTTP(w, newOAuthCallback("fooshop.myshopify.com", state, cookie))
+
+	if w.Code != http.StatusFound {
+		t.Fatalf("OAuthHandler callback returned status %d, expected %d", w.Code, http.StatusFound)
+	}
+
+	expectedLocation := "https://fooshop.myshopify.com/admin/apps/apikey"
+	if location := w.Header().Get("Location"); location != expectedLocation {
+		t.Errorf("OAuthHandler callback redirected to %s, expected %s", location, expectedLocation)
+	}
+
+	if installedShop != "fooshop.myshopify.com" || installedToken != "footoken" {
+		t.Errorf("OnInstalled called with %s, %s, expected fooshop.myshopify.com, footoken", installedShop, installedToken)
+	}
+	expectedScopes := []string{"read_products", "write_orders"}
+	if !reflect.DeepEqual(installedScopes, expectedScopes) {
+		t.Errorf("OnInstalled called with scopes %v, expected %v", installedScopes, expectedScopes)
+	}
+
+	cleared := w.Result().Cookies()
+	if len(cleared) != 1 || cleared[0].MaxAge >= 0 {
+		t.Errorf("OAuthHandler callback set cookies %+v, expected the state cookie to be cleared", cleared)
+	}
+}
+
+func TestOAuthHandlerCallbackClientOptions(t *testing.T) {
+	shop := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"localtoken","scope":"read_products"}`))
+	}))
+	defer shop.Close()
+
+	h := mustNewOAuthHandler(app)
+	h.ClientOptions = []Option{WithBaseURL(shop.URL)}
+	var installedToken string
+	h.OnInstalled = func(shop, token string, scopes []string) error {
+		installedToken = token
+		return nil
+	}
+
+	state, cookie := startOAuth(t, h, "fooshop.myshopify.com")
+	w := httptest.NewRecorder()
+	h.CallbackHandler().ServeHTTP(w, newOAuthCallback("fooshop.myshopify.com", state, cookie))
+
+	if w.Code != http.StatusFound || installedToken != "localtoken" {
+		t.Errorf("OAuthHandler callback returned status %d and token %q, expected %d and the token of the local shop", w.Code, installedToken, http.StatusFound)
+	}
+}
+
+func TestOAuthHandlerCallbackReturnURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewStringResponder(200, `{"access_token":"footoken","scope":"read_products"}`))
+
+	h := mustNewOAuthHandler(app)
+	h.ReturnURL = func(shop string) string {
+		return "https://app.example.com/welcome?shop=" + shop
+	}
+
+	state, cookie := startOAuth(t, h, "fooshop.myshopify.com")
+	w := httptest.NewRecorder()
+	h.CallbackHandler().ServeHTTP(w, newOAuthCallback("fooshop.myshopify.com", state, cookie))
+
+	expected := "https://app.example.com/welcome?shop=fooshop.myshopify.com"
+	if location := w.Header().Get("Location"); location != expected {
+		t.Errorf("OAuthHandler callback redirected to %s, expected %s", location, expected)
+	}
+}
+
+func TestOAuthHandlerCallbackRejected(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewStringResponder(200, `{"access_token":"footoken","scope":"read_products"}`))
+
+	h := mustNewOAuthHandler(app)
+	state, cookie := startOAuth(t, h, "fooshop.myshopify.com")
+	_, otherCookie := startOAuth(t, h, "barshop.myshopify.com")
+
+	unsigned := newOAuthCallback("fooshop.myshopify.com", state, cookie)
+	unsigned.URL.RawQuery += "&extra=1"
+
+	forged := *cookie
+	forged.Value = "a" + cookie.Value[1:]
+
+	cases := []struct {
+		name     string
+		req      *http.Request
+		status   int
+		expected error
+	}{
+		{"invalid shop", newOAuthCallback("evil.com", state, cookie), http.StatusBadRequest, ErrInvalidShop},
+		{"invalid hmac", unsigned, http.StatusUnauthorized, ErrOAuthInvalidHMAC},
+		{"missing cookie", newOAuthCallback("fooshop.myshopify.com", state, nil), http.StatusForbidden, ErrOAuthInvalidState},
+		{"wrong state", newOAuthCallback("fooshop.myshopify.com", "guessed", cookie), http.StatusForbidden, ErrOAuthInvalidState},
+		{"other shop", newOAuthCallback("fooshop.myshopify.com", state, otherCookie), http.StatusForbidden, ErrOAuthInvalidState},
+		{"forged cookie", newOAuthCallback("fooshop.myshopify.com", state, &forged), http.StatusForbidden, ErrOAuthInvalidState},
+	}
+
+	for _, c := range cases {
+		var reported error
+		installed := false
+		h.OnError = func(r *http.Request, err error) { reported = err }
+		h.OnInstalled = func(shop, token string, scopes []string) error {
+			installed = true
+			return nil
+		}
+
+		w := httptest.NewRecorder()
+		h.CallbackHandler().ServeHTTP(w, c.req)
+
+		if w.Code != c.status {
+			t.Errorf("OAuthHandler callback with %s returned status %d, expected %d", c.name, w.Code, c.status)
+		}
+		if reported != c.expected {
+			t.Errorf("OAuthHandler callback with %s reported %v, expected %v", c.name, reported, c.expected)
+		}
+		if installed {
+			t.Errorf("OAuthHandler callback with %s called OnInstalled", c.name)
+		}
+	}
+}
+
+func TestOAuthHandlerCallbackInstallError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewStringResponder(200, `{"access_token":"footoken","scope":"read_products"}`))
+
+	h := mustNewOAuthHandler(app)
+	h.OnInstalled = func(shop, token string, scopes []string) error {
+		return errors.New("database down")
+	}
+
+	state, cookie := startOAuth(t, h, "fooshop.myshopify.com")
+	w := httptest.NewRecorder()
+	h.CallbackHandler().ServeHTTP(w, newOAuthCallback("fooshop.myshopify.com", state, cookie))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("OAuthHandler callback returned status %d, expected %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestOAuthHandlerCallbackTokenError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewStringResponder(400, `{"errors":"invalid code"}`))
+
+	h := mustNewOAuthHandler(app)
+	var reported error
+	h.OnError = func(r *http.Request, err error) { reported = err }
+	h.OnInstalled = func(shop, token string, scopes []string) error {
+		t.Errorf("OnInstalled called for a failed token exchange")
+		return nil
+	}
+
+	state, cookie := startOAuth(t, h, "fooshop.myshopify.com")
+	w := httptest.NewRecorder()
+	h.CallbackHandler().ServeHTTP(w, newOAuthCallback("fooshop.myshopify.com", state, cookie))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("OAuthHandler callback returned status %d, expected %d", w.Code, http.StatusInternalServerError)
+	}
+	if _, ok := reported.(ResponseError); !ok {
+		t.Errorf("OAuthHandler callback reported %#v, expected a ResponseError", reported)
+	}
+}
+
+func TestCookieStateStoreExpired(t *testing.T) {
+	store, err := NewCookieStateStore("hush")
+	if err != nil {
+		t.Fatalf("NewCookieStateStore returned error: %v", err)
+	}
+	clock := &fakeClock{t: time.Unix(1500000000, 0)}
+	store.now = clock.now
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "https://app.example.com/install", nil)
+	store.Save(w, req, "fooshop.myshopify.com", "thestate")
+	cookie := w.Result().Cookies()[0]
+
+	clock.advance(11 * time.Minute)
+
+	req = httptest.NewRequest("GET", "https://example.com/callback", nil)
+	req.AddCookie(cookie)
+	ok, err := store.Consume(httptest.NewRecorder(), req, "fooshop.myshopify.com", "thestate")
+	if err != nil {
+		t.Fatalf("CookieStateStore.Consume returned error: %v", err)
+	}
+	if ok {
+		t.Errorf("CookieStateStore.Consume accepted an expired state")
+	}
+}
+
+func TestOAuthHandlerEmptySecret(t *testing.T) {
+	if _, err := NewOAuthHandler(App{APIKey: "apikey"}); err != ErrOAuthEmptySecret {
+		t.Errorf("NewOAuthHandler without a secret returned %v, expected ErrOAuthEmptySecret", err)
+	}
+	if _, err := NewCookieStateStore(""); err != ErrOAuthEmptySecret {
+		t.Errorf("NewCookieStateStore without a secret returned %v, expected ErrOAuthEmptySecret", err)
+	}
+
+	store := &CookieStateStore{Name: "shopify_oauth_state"}
+	req := httptest.NewRequest("GET", "https://app.example.com/install", nil)
+	if err := store.Save(httptest.NewRecorder(), req, "fooshop.myshopify.com", "thestate"); err != ErrOAuthEmptySecret {
+		t.Errorf("CookieStateStore.Save without a secret returned %v, expected ErrOAuthEmptySecret", err)
+	}
+	if ok, err := store.Consume(httptest.NewRecorder(), req, "fooshop.myshopify.com", "thestate"); ok || err != ErrOAuthEmptySecret {
+		t.Errorf("CookieStateStore.Consume without a secret returned %v, %v, expected ErrOAuthEmptySecret", ok, err)
+	}
+}
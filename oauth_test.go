@@ -2,12 +2,14 @@ package goshopify
 
 import (
 	"net/url"
+	"reflect"
 	"testing"
 
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 
 	"gopkg.in/jarcoal/httpmock.v1"
 )
@@ -51,6 +53,110 @@ func TestAppGetAccessToken(t *testing.T) {
 	}
 }
 
+func TestAppGetAccessTokenInvalidShop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	token, err := app.GetAccessToken("fooshop.example.com", "foocode")
+	if err != ErrInvalidShop {
+		t.Errorf("App.GetAccessToken() returned error %v, expected ErrInvalidShop", err)
+	}
+	if token != "" {
+		t.Errorf("Token = %v, expected no token", token)
+	}
+}
+
+func TestAppGetAccessTokenOptions(t *testing.T) {
+	shop := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/oauth/access_token" {
+			t.Errorf("Access token request sent to %s, expected /admin/oauth/access_token", r.URL.Path)
+		}
+		w.Write([]byte(`{"access_token":"footoken"}`))
+	}))
+	defer shop.Close()
+
+	logger := &testLogger{}
+	token, err := app.GetAccessToken("fooshop", "foocode", WithBaseURL(shop.URL), WithLogger(logger))
+	if err != nil {
+		t.Fatalf("App.GetAccessToken(): %v", err)
+	}
+	if token != "footoken" {
+		t.Errorf("Token = %v, expected %v", token, "footoken")
+	}
+	if logger.find("shopify: request") == nil {
+		t.Errorf("Logger received %+v, expected the access token request", logger.records)
+	}
+}
+
+func TestAppAuthorizeURLWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		options  AuthorizeOptions
+		expected string
+	}{
+		{AuthorizeOptions{}, "https://fooshop.myshopify.com/admin/oauth/authorize?client_id=apikey&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback&scope=read_products&state=thenonce"},
+		{AuthorizeOptions{Online: true}, "https://fooshop.myshopify.com/admin/oauth/authorize?client_id=apikey&grant_options%5B%5D=per-user&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback&scope=read_products&state=thenonce"},
+		{AuthorizeOptions{Scope: "read_products,write_orders"}, "https://fooshop.myshopify.com/admin/oauth/authorize?client_id=apikey&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback&scope=read_products%2Cwrite_orders&state=thenonce"},
+	}
+
+	for _, c := range cases {
+		actual := app.AuthorizeURLWithOptions("fooshop", "thenonce", c.options)
+		if actual != c.expected {
+			t.Errorf("App.AuthorizeURLWithOptions(%+v): expected %s, actual %s", c.options, c.expected, actual)
+		}
+	}
+}
+
+func TestAppGetAccessTokenResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewBytesResponder(200, loadFixture("access_token_online.json")))
+
+	token, err := app.GetAccessTokenResponse("fooshop", "foocode")
+	if err != nil {
+		t.Fatalf("App.GetAccessTokenResponse(): %v", err)
+	}
+
+	expected := &AccessTokenResponse{
+		AccessToken:         "f85632530bf277ec9ac6f649fc327f17",
+		Scope:               "write_orders,read_customers",
+		ExpiresIn:           86399,
+		AssociatedUserScope: "write_orders",
+		AssociatedUser: &AssociatedUser{
+			ID:            902541635,
+			FirstName:     "John",
+			LastName:      "Smith",
+			Email:         "john@example.com",
+			EmailVerified: true,
+			AccountOwner:  true,
+			Locale:        "en",
+		},
+	}
+	if !reflect.DeepEqual(token, expected) {
+		t.Errorf("App.GetAccessTokenResponse() returned %+v, expected %+v", token, expected)
+	}
+
+	if !token.Online() {
+		t.Errorf("AccessTokenResponse.Online() returned false, expected true")
+	}
+
+	expectedScopes := Scopes{"write_orders", "read_customers"}
+	if !reflect.DeepEqual(token.Scopes(), expectedScopes) {
+		t.Errorf("AccessTokenResponse.Scopes() returned %v, expected %v", token.Scopes(), expectedScopes)
+	}
+}
+
+func TestAccessTokenResponseOffline(t *testing.T) {
+	token := AccessTokenResponse{AccessToken: "footoken", Scope: "read_products"}
+	if token.Online() {
+		t.Errorf("AccessTokenResponse.Online() returned true for an offline token")
+	}
+}
+
 func TestAppVerifyAuthorizationURL(t *testing.T) {
 	// These credentials are from the Shopify example page:
 	// https://help.shopify.com/api/guides/authentication/oauth#verification
@@ -97,7 +203,7 @@ func TestVerifyWebhookRequest(t *testing.T) {
 
 	for _, c := range cases {
 
-		testClient := NewClient(App{}, "", "")
+		testClient := mustNewClient(App{}, "fooshop", "")
 		req, err := testClient.NewRequest("GET", "", c.message, nil)
 		if err != nil {
 			t.Fatalf("Webhook.verify err = %v, expected true", err)
@@ -150,7 +256,7 @@ func TestVerifyWebhookRequestVerbose(t *testing.T) {
 
 	for _, c := range cases {
 
-		testClient := NewClient(App{}, "", "")
+		testClient := mustNewClient(App{}, "fooshop", "")
 
 		// We actually want to test nil body's, not ""
 		if c.message == "" {
@@ -0,0 +1,94 @@
+package goshopify
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(c *Client) error
+
+// WithHTTPClient sets the HTTP client used to send requests, e.g. to use a
+// custom transport, timeout or proxy. http.DefaultClient is used by
+// default.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) error {
+		if client == nil {
+			return errors.New("nil HTTP client")
+		}
+		c.Client = client
+		return nil
+	}
+}
+
+// WithBaseURL sends requests to baseURL instead of the shop's myshopify.com
+// domain, e.g. to a local fake of the Admin API.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return errors.New("base URL must be absolute: " + baseURL)
+		}
+		c.baseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent appends an app-specific suffix like "myapp/1.2" to the
+// User-Agent header of requests.
+func WithUserAgent(suffix string) Option {
+	return func(c *Client) error {
+		suffix = strings.TrimSpace(suffix)
+		if suffix != "" {
+			c.userAgent = UserAgent + " " + suffix
+		}
+		return nil
+	}
+}
+
+// WithLogger sets the Logger of the client.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithDebugDump makes the client log the headers and bodies of every call at
+// Debug level, with tokens and customer data redacted. It has no effect
+// without a Logger.
+func WithDebugDump() Option {
+	return func(c *Client) error {
+		c.DebugDump = true
+		return nil
+	}
+}
+
+// WithRetry sets the Retry policy of the client.
+func WithRetry(policy *RetryPolicy) Option {
+	return func(c *Client) error {
+		c.Retry = policy
+		return nil
+	}
+}
+
+// WithRateLimiter sets the RateLimiter of the client.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) error {
+		c.RateLimiter = limiter
+		return nil
+	}
+}
+
+// WithRateLimitStore sets the RateLimitStore of the client.
+func WithRateLimitStore(store RateLimitStore) Option {
+	return func(c *Client) error {
+		c.RateLimitStore = store
+		return nil
+	}
+}
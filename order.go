@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,10 +16,28 @@ const ordersResourceName = "orders"
 // See: https://help.shopify.com/api/reference/order
 type OrderAPI interface {
 	List(interface{}) ([]Order, error)
+	ListWithContext(context.Context, interface{}) ([]Order, error)
+	ListWithPagination(interface{}) ([]Order, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Order, *Pagination, error)
+	ListAll(interface{}) ([]Order, error)
+	ListAllWithContext(context.Context, interface{}) ([]Order, error)
+	Stream(context.Context, interface{}) (<-chan Order, <-chan error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Order, error)
+	GetWithContext(context.Context, int, interface{}) (*Order, error)
 	Create(Order) (*Order, error)
+	CreateWithContext(context.Context, Order) (*Order, error)
 	Update(Order) (*Order, error)
+	UpdateWithContext(context.Context, Order) (*Order, error)
+	Cancel(int, OrderCancelOptions) (*Order, error)
+	CancelWithContext(context.Context, int, OrderCancelOptions) (*Order, error)
+	Close(int) (*Order, error)
+	CloseWithContext(context.Context, int) (*Order, error)
+	Open(int) (*Order, error)
+	OpenWithContext(context.Context, int) (*Order, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 
 	// MetafieldsAPI used for Order resource to communicate with Metafields resource
 	MetafieldsAPI
@@ -194,6 +213,7 @@ type LineItem struct {
 	TaxLines                   []TaxLine        `json:"tax_lines,omitempty"`
 	OriginLocation             *Address         `json:"origin_location,omitempty"`
 	DestinationLocation        *Address         `json:"destination_location,omitempty"`
+	AppliedDiscount            *AppliedDiscount `json:"applied_discount,omitempty"`
 }
 
 // LineItemProperty line item property struct
@@ -207,6 +227,16 @@ type NoteAttribute struct {
 	Value interface{} `json:"value,omitempty"`
 }
 
+// OrderCancelOptions a struct for all available order cancel options
+type OrderCancelOptions struct {
+	Amount   *decimal.Decimal `json:"amount,omitempty"`
+	Currency string           `json:"currency,omitempty"`
+	Restock  bool             `json:"restock,omitempty"`
+	Reason   string           `json:"reason,omitempty"`
+	Email    bool             `json:"email,omitempty"`
+	Refund   *Refund          `json:"refund,omitempty"`
+}
+
 // OrderResource represents the result from the orders/X.json endpoint
 type OrderResource struct {
 	Order *Order `json:"order"`
@@ -283,148 +313,374 @@ type ClientDetails struct {
 
 // List orders
 func (s *OrderAPIOp) List(options interface{}) ([]Order, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *OrderAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Order, error) {
 	path := fmt.Sprintf("%s.json", ordersBasePath)
 	resource := new(OrdersResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Orders, err
 }
 
+// ListWithPagination lists orders and returns the pagination to request the
+// next and previous pages.
+func (s *OrderAPIOp) ListWithPagination(options interface{}) ([]Order, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *OrderAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Order, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", ordersBasePath)
+	return listPage(ctx, s.client, path, options, func(r *OrdersResource) []Order { return r.Orders })
+}
+
+// ListAll lists all orders, following the pagination from the page
+// requested by options to the last page.
+func (s *OrderAPIOp) ListAll(options interface{}) ([]Order, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *OrderAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Order, error) {
+	path := fmt.Sprintf("%s.json", ordersBasePath)
+	return listAll(ctx, s.client, path, options, func(r *OrdersResource) []Order { return r.Orders })
+}
+
+// Stream lists all orders like ListAll, but sends them on the returned
+// channel while the following pages are fetched. Up to 250 orders are
+// buffered ahead of the receiver, plus the page being fetched. The error
+// channel receives the error that ended the listing, if any. Both channels
+// are closed when the listing ends.
+func (s *OrderAPIOp) Stream(ctx context.Context, options interface{}) (<-chan Order, <-chan error) {
+	orders := make(chan Order, streamBufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(orders)
+		defer close(errs)
+
+		path := fmt.Sprintf("%s.json", ordersBasePath)
+		pager := s.client.NewPager(path, options)
+		for pager.HasNext() {
+			resource := new(OrdersResource)
+			if err := pager.NextWithContext(ctx, resource); err != nil {
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+				errs <- err
+				return
+			}
+			for _, order := range resource.Orders {
+				select {
+				case orders <- order:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+
+	return orders, errs
+}
+
 // Count orders
 func (s *OrderAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *OrderAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", ordersBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual order
 func (s *OrderAPIOp) Get(orderID int, options interface{}) (*Order, error) {
+	return s.GetWithContext(context.Background(), orderID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *OrderAPIOp) GetWithContext(ctx context.Context, orderID int, options interface{}) (*Order, error) {
 	path := fmt.Sprintf("%s/%d.json", ordersBasePath, orderID)
 	resource := new(OrderResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Order, err
 }
 
 // Create order
 func (s *OrderAPIOp) Create(order Order) (*Order, error) {
+	return s.CreateWithContext(context.Background(), order)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *OrderAPIOp) CreateWithContext(ctx context.Context, order Order) (*Order, error) {
 	path := fmt.Sprintf("%s.json", ordersBasePath)
 	wrappedData := OrderResource{Order: &order}
 	resource := new(OrderResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Order, err
 }
 
 // Update order
 func (s *OrderAPIOp) Update(order Order) (*Order, error) {
+	return s.UpdateWithContext(context.Background(), order)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *OrderAPIOp) UpdateWithContext(ctx context.Context, order Order) (*Order, error) {
 	path := fmt.Sprintf("%s/%d.json", ordersBasePath, order.ID)
 	wrappedData := OrderResource{Order: &order}
 	resource := new(OrderResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
+	return resource.Order, err
+}
+
+// Cancel order
+func (s *OrderAPIOp) Cancel(orderID int, options OrderCancelOptions) (*Order, error) {
+	return s.CancelWithContext(context.Background(), orderID, options)
+}
+
+// CancelWithContext is the context-aware variant of Cancel.
+func (s *OrderAPIOp) CancelWithContext(ctx context.Context, orderID int, options OrderCancelOptions) (*Order, error) {
+	path := fmt.Sprintf("%s/%d/cancel.json", ordersBasePath, orderID)
+	resource := new(OrderResource)
+	err := s.client.PostWithContext(ctx, path, options, resource)
+	return resource.Order, err
+}
+
+// Close order
+func (s *OrderAPIOp) Close(orderID int) (*Order, error) {
+	return s.CloseWithContext(context.Background(), orderID)
+}
+
+// CloseWithContext is the context-aware variant of Close.
+func (s *OrderAPIOp) CloseWithContext(ctx context.Context, orderID int) (*Order, error) {
+	path := fmt.Sprintf("%s/%d/close.json", ordersBasePath, orderID)
+	resource := new(OrderResource)
+	err := s.client.PostWithContext(ctx, path, nil, resource)
+	return resource.Order, err
+}
+
+// Open re-opens a closed order
+func (s *OrderAPIOp) Open(orderID int) (*Order, error) {
+	return s.OpenWithContext(context.Background(), orderID)
+}
+
+// OpenWithContext is the context-aware variant of Open.
+func (s *OrderAPIOp) OpenWithContext(ctx context.Context, orderID int) (*Order, error) {
+	path := fmt.Sprintf("%s/%d/open.json", ordersBasePath, orderID)
+	resource := new(OrderResource)
+	err := s.client.PostWithContext(ctx, path, nil, resource)
 	return resource.Order, err
 }
 
+// Delete order
+func (s *OrderAPIOp) Delete(orderID int) error {
+	return s.DeleteWithContext(context.Background(), orderID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *OrderAPIOp) DeleteWithContext(ctx context.Context, orderID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", ordersBasePath, orderID))
+}
+
 // ListMetafields list metafields for an order
 func (s *OrderAPIOp) ListMetafields(orderID int, options interface{}) ([]Metafield, error) {
+	return s.ListMetafieldsWithContext(context.Background(), orderID, options)
+}
+
+// ListMetafieldsWithContext is the context-aware variant of ListMetafields.
+func (s *OrderAPIOp) ListMetafieldsWithContext(ctx context.Context, orderID int, options interface{}) ([]Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return metafieldAPI.List(options)
+	return metafieldAPI.ListWithContext(ctx, options)
 }
 
 // CountMetafields count metafields for an order
 func (s *OrderAPIOp) CountMetafields(orderID int, options interface{}) (int, error) {
+	return s.CountMetafieldsWithContext(context.Background(), orderID, options)
+}
+
+// CountMetafieldsWithContext is the context-aware variant of CountMetafields.
+func (s *OrderAPIOp) CountMetafieldsWithContext(ctx context.Context, orderID int, options interface{}) (int, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return metafieldAPI.Count(options)
+	return metafieldAPI.CountWithContext(ctx, options)
 }
 
 // GetMetafield get individual metafield for an order
 func (s *OrderAPIOp) GetMetafield(orderID int, metafieldID int, options interface{}) (*Metafield, error) {
+	return s.GetMetafieldWithContext(context.Background(), orderID, metafieldID, options)
+}
+
+// GetMetafieldWithContext is the context-aware variant of GetMetafield.
+func (s *OrderAPIOp) GetMetafieldWithContext(ctx context.Context, orderID int, metafieldID int, options interface{}) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return metafieldAPI.Get(metafieldID, options)
+	return metafieldAPI.GetWithContext(ctx, metafieldID, options)
 }
 
 // CreateMetafield create a new metafield for an order
 func (s *OrderAPIOp) CreateMetafield(orderID int, metafield Metafield) (*Metafield, error) {
+	return s.CreateMetafieldWithContext(context.Background(), orderID, metafield)
+}
+
+// CreateMetafieldWithContext is the context-aware variant of CreateMetafield.
+func (s *OrderAPIOp) CreateMetafieldWithContext(ctx context.Context, orderID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return metafieldAPI.Create(metafield)
+	return metafieldAPI.CreateWithContext(ctx, metafield)
 }
 
 // UpdateMetafield update an existing metafield for an order
 func (s *OrderAPIOp) UpdateMetafield(orderID int, metafield Metafield) (*Metafield, error) {
+	return s.UpdateMetafieldWithContext(context.Background(), orderID, metafield)
+}
+
+// UpdateMetafieldWithContext is the context-aware variant of UpdateMetafield.
+func (s *OrderAPIOp) UpdateMetafieldWithContext(ctx context.Context, orderID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return metafieldAPI.Update(metafield)
+	return metafieldAPI.UpdateWithContext(ctx, metafield)
 }
 
 // DeleteMetafield delete an existing metafield for an order
 func (s *OrderAPIOp) DeleteMetafield(orderID int, metafieldID int) error {
+	return s.DeleteMetafieldWithContext(context.Background(), orderID, metafieldID)
+}
+
+// DeleteMetafieldWithContext is the context-aware variant of DeleteMetafield.
+func (s *OrderAPIOp) DeleteMetafieldWithContext(ctx context.Context, orderID int, metafieldID int) error {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return metafieldAPI.Delete(metafieldID)
+	return metafieldAPI.DeleteWithContext(ctx, metafieldID)
 }
 
 // ListFulfillments list fulfillments for an order
 func (s *OrderAPIOp) ListFulfillments(orderID int, options interface{}) ([]Fulfillment, error) {
+	return s.ListFulfillmentsWithContext(context.Background(), orderID, options)
+}
+
+// ListFulfillmentsWithContext is the context-aware variant of ListFulfillments.
+func (s *OrderAPIOp) ListFulfillmentsWithContext(ctx context.Context, orderID int, options interface{}) ([]Fulfillment, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.List(options)
+	return fulfillmentAPI.ListWithContext(ctx, options)
 }
 
 // CountFulfillments count fulfillments for an order
 func (s *OrderAPIOp) CountFulfillments(orderID int, options interface{}) (int, error) {
+	return s.CountFulfillmentsWithContext(context.Background(), orderID, options)
+}
+
+// CountFulfillmentsWithContext is the context-aware variant of CountFulfillments.
+func (s *OrderAPIOp) CountFulfillmentsWithContext(ctx context.Context, orderID int, options interface{}) (int, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.Count(options)
+	return fulfillmentAPI.CountWithContext(ctx, options)
 }
 
 // GetFulfillment get individual fulfillment for an order
 func (s *OrderAPIOp) GetFulfillment(orderID int, fulfillmentID int, options interface{}) (*Fulfillment, error) {
+	return s.GetFulfillmentWithContext(context.Background(), orderID, fulfillmentID, options)
+}
+
+// GetFulfillmentWithContext is the context-aware variant of GetFulfillment.
+func (s *OrderAPIOp) GetFulfillmentWithContext(ctx context.Context, orderID int, fulfillmentID int, options interface{}) (*Fulfillment, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.Get(fulfillmentID, options)
+	return fulfillmentAPI.GetWithContext(ctx, fulfillmentID, options)
 }
 
 // CreateFulfillment create a new fulfillment for an order
 func (s *OrderAPIOp) CreateFulfillment(orderID int, fulfillment Fulfillment) (*Fulfillment, error) {
+	return s.CreateFulfillmentWithContext(context.Background(), orderID, fulfillment)
+}
+
+// CreateFulfillmentWithContext is the context-aware variant of CreateFulfillment.
+func (s *OrderAPIOp) CreateFulfillmentWithContext(ctx context.Context, orderID int, fulfillment Fulfillment) (*Fulfillment, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.Create(fulfillment)
+	return fulfillmentAPI.CreateWithContext(ctx, fulfillment)
 }
 
 // UpdateFulfillment update an existing fulfillment for an order
 func (s *OrderAPIOp) UpdateFulfillment(orderID int, fulfillment Fulfillment) (*Fulfillment, error) {
+	return s.UpdateFulfillmentWithContext(context.Background(), orderID, fulfillment)
+}
+
+// UpdateFulfillmentWithContext is the context-aware variant of UpdateFulfillment.
+func (s *OrderAPIOp) UpdateFulfillmentWithContext(ctx context.Context, orderID int, fulfillment Fulfillment) (*Fulfillment, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.Update(fulfillment)
+	return fulfillmentAPI.UpdateWithContext(ctx, fulfillment)
 }
 
 // CompleteFulfillment complete an existing fulfillment for an order
 func (s *OrderAPIOp) CompleteFulfillment(orderID int, fulfillmentID int) (*Fulfillment, error) {
+	return s.CompleteFulfillmentWithContext(context.Background(), orderID, fulfillmentID)
+}
+
+// CompleteFulfillmentWithContext is the context-aware variant of CompleteFulfillment.
+func (s *OrderAPIOp) CompleteFulfillmentWithContext(ctx context.Context, orderID int, fulfillmentID int) (*Fulfillment, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.Complete(fulfillmentID)
+	return fulfillmentAPI.CompleteWithContext(ctx, fulfillmentID)
 }
 
 // OpenFulfillment open an existing fulfillment for an order
 func (s *OrderAPIOp) OpenFulfillment(orderID int, fulfillmentID int) (*Fulfillment, error) {
+	return s.OpenFulfillmentWithContext(context.Background(), orderID, fulfillmentID)
+}
+
+// OpenFulfillmentWithContext is the context-aware variant of OpenFulfillment.
+func (s *OrderAPIOp) OpenFulfillmentWithContext(ctx context.Context, orderID int, fulfillmentID int) (*Fulfillment, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.Open(fulfillmentID)
+	return fulfillmentAPI.OpenWithContext(ctx, fulfillmentID)
 }
 
 // CancelFulfillment cancel an existing fulfillment for an order
 func (s *OrderAPIOp) CancelFulfillment(orderID int, fulfillmentID int) (*Fulfillment, error) {
+	return s.CancelFulfillmentWithContext(context.Background(), orderID, fulfillmentID)
+}
+
+// CancelFulfillmentWithContext is the context-aware variant of CancelFulfillment.
+func (s *OrderAPIOp) CancelFulfillmentWithContext(ctx context.Context, orderID int, fulfillmentID int) (*Fulfillment, error) {
 	fulfillmentAPI := &FulfillmentAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return fulfillmentAPI.Cancel(fulfillmentID)
+	return fulfillmentAPI.CancelWithContext(ctx, fulfillmentID)
 }
 
 // ListRefunds list refunds for an order
 func (s *OrderAPIOp) ListRefunds(orderID int, options interface{}) ([]Refund, error) {
+	return s.ListRefundsWithContext(context.Background(), orderID, options)
+}
+
+// ListRefundsWithContext is the context-aware variant of ListRefunds.
+func (s *OrderAPIOp) ListRefundsWithContext(ctx context.Context, orderID int, options interface{}) ([]Refund, error) {
 	refundAPI := &RefundAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return refundAPI.List(options)
+	return refundAPI.ListWithContext(ctx, options)
 }
 
 // GetRefund get individual refund for an order
 func (s *OrderAPIOp) GetRefund(orderID int, refundID int, options interface{}) (*Refund, error) {
+	return s.GetRefundWithContext(context.Background(), orderID, refundID, options)
+}
+
+// GetRefundWithContext is the context-aware variant of GetRefund.
+func (s *OrderAPIOp) GetRefundWithContext(ctx context.Context, orderID int, refundID int, options interface{}) (*Refund, error) {
 	refundAPI := &RefundAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return refundAPI.Get(refundID, options)
+	return refundAPI.GetWithContext(ctx, refundID, options)
 }
 
 // CalculateRefund calculate a refund for an order
 func (s *OrderAPIOp) CalculateRefund(orderID int, refund Refund) (*Refund, error) {
+	return s.CalculateRefundWithContext(context.Background(), orderID, refund)
+}
+
+// CalculateRefundWithContext is the context-aware variant of CalculateRefund.
+func (s *OrderAPIOp) CalculateRefundWithContext(ctx context.Context, orderID int, refund Refund) (*Refund, error) {
 	refundAPI := &RefundAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return refundAPI.Calculate(refund)
+	return refundAPI.CalculateWithContext(ctx, refund)
 }
 
 // CreateRefund create a new refund for an order
 func (s *OrderAPIOp) CreateRefund(orderID int, refund Refund) (*Refund, error) {
+	return s.CreateRefundWithContext(context.Background(), orderID, refund)
+}
+
+// CreateRefundWithContext is the context-aware variant of CreateRefund.
+func (s *OrderAPIOp) CreateRefundWithContext(ctx context.Context, orderID int, refund Refund) (*Refund, error) {
 	refundAPI := &RefundAPIOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
-	return refundAPI.Create(refund)
+	return refundAPI.CreateWithContext(ctx, refund)
 }
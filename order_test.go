@@ -1,6 +1,9 @@
 package goshopify
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -85,6 +88,127 @@ func TestOrderList(t *testing.T) {
 	orderTests(t, order)
 }
 
+func TestOrderListWithContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders.json",
+		httpmock.NewBytesResponder(200, loadFixture("orders.json")))
+
+	orders, err := client.Order.ListWithContext(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Order.ListWithContext returned error: %v", err)
+	}
+
+	if len(orders) != 1 {
+		t.Errorf("Order.ListWithContext got %v orders, expected: 1", len(orders))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = client.Order.ListWithContext(ctx, nil)
+	if err == nil {
+		t.Errorf("Order.ListWithContext with canceled context returned nil error")
+	}
+}
+
+func TestOrderListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders.json",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, loadFixture("orders.json"))
+			resp.Header.Set("Link", `<https://fooshop.myshopify.com/admin/orders.json?page_info=next&limit=1>; rel="next"`)
+			return resp, nil
+		})
+
+	orders, pagination, err := client.Order.ListWithPagination(OrderListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("Order.ListWithPagination returned error: %v", err)
+	}
+
+	if len(orders) != 1 {
+		t.Errorf("Order.ListWithPagination got %v orders, expected: 1", len(orders))
+	}
+
+	expected := &Pagination{NextPageOptions: &ListOptions{PageInfo: "next", Limit: 1}}
+	if !reflect.DeepEqual(pagination, expected) {
+		t.Errorf("Order.ListWithPagination returned pagination %+v, expected %+v", pagination, expected)
+	}
+}
+
+func TestOrderListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders.json",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, loadFixture("orders.json"))
+			if req.URL.Query().Get("page_info") == "" {
+				resp.Header.Set("Link", `<https://fooshop.myshopify.com/admin/orders.json?page_info=next&limit=1>; rel="next"`)
+			}
+			return resp, nil
+		})
+
+	orders, err := client.Order.ListAll(OrderListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("Order.ListAll returned error: %v", err)
+	}
+
+	if len(orders) != 2 {
+		t.Errorf("Order.ListAll got %v orders, expected: 2", len(orders))
+	}
+}
+
+func TestOrderStream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders.json",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, loadFixture("orders.json"))
+			if req.URL.Query().Get("page_info") == "" {
+				resp.Header.Set("Link", `<https://fooshop.myshopify.com/admin/orders.json?page_info=next&limit=1>; rel="next"`)
+			}
+			return resp, nil
+		})
+
+	orders, errs := client.Order.Stream(context.Background(), OrderListOptions{Limit: 1})
+
+	count := 0
+	for order := range orders {
+		orderTests(t, order)
+		count++
+	}
+	if err := <-errs; err != nil {
+		t.Errorf("Order.Stream returned error: %v", err)
+	}
+
+	if count != 2 {
+		t.Errorf("Order.Stream sent %v orders, expected: 2", count)
+	}
+}
+
+func TestOrderStreamError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders.json",
+		httpmock.NewStringResponder(500, `{"errors":"Internal"}`))
+
+	orders, errs := client.Order.Stream(context.Background(), nil)
+	for range orders {
+		t.Errorf("Order.Stream sent an order, expected none")
+	}
+
+	err := <-errs
+	if e, ok := err.(ResponseError); !ok || e.Status != 500 {
+		t.Errorf("Order.Stream returned error %#v, expected ResponseError", err)
+	}
+}
+
 func TestOrderListOptions(t *testing.T) {
 	setup()
 	defer teardown()
@@ -259,6 +383,98 @@ func TestOrderUpdate(t *testing.T) {
 	}
 }
 
+func TestOrderCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/1/cancel.json",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"amount":"10","currency":"USD","restock":true,"reason":"customer","email":true}`
+			if string(body) != expected {
+				t.Errorf("Order.Cancel sent body %s, expected %s", body, expected)
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("order_cancel.json")), nil
+		})
+
+	amount := decimal.NewFromFloat(10)
+	options := OrderCancelOptions{
+		Amount:   &amount,
+		Currency: "USD",
+		Restock:  true,
+		Reason:   "customer",
+		Email:    true,
+	}
+
+	order, err := client.Order.Cancel(1, options)
+	if err != nil {
+		t.Errorf("Order.Cancel returned error: %v", err)
+	}
+
+	if order.ID != 1 {
+		t.Errorf("Order.Cancel returned id %d, expected %d", order.ID, 1)
+	}
+	if order.CancelledAt == nil {
+		t.Errorf("Order.Cancel returned CancelledAt nil, expected a time")
+	}
+	if order.CancelReason != "customer" {
+		t.Errorf("Order.Cancel returned CancelReason %q, expected %q", order.CancelReason, "customer")
+	}
+}
+
+func TestOrderClose(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/1/close.json",
+		httpmock.NewBytesResponder(200, loadFixture("order_close.json")))
+
+	order, err := client.Order.Close(1)
+	if err != nil {
+		t.Errorf("Order.Close returned error: %v", err)
+	}
+
+	if order.ID != 1 {
+		t.Errorf("Order.Close returned id %d, expected %d", order.ID, 1)
+	}
+	if order.ClosedAt == nil {
+		t.Errorf("Order.Close returned ClosedAt nil, expected a time")
+	}
+}
+
+func TestOrderOpen(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/1/open.json",
+		httpmock.NewBytesResponder(200, loadFixture("order_open.json")))
+
+	order, err := client.Order.Open(1)
+	if err != nil {
+		t.Errorf("Order.Open returned error: %v", err)
+	}
+
+	if order.ID != 1 {
+		t.Errorf("Order.Open returned id %d, expected %d", order.ID, 1)
+	}
+	if order.ClosedAt != nil {
+		t.Errorf("Order.Open returned ClosedAt %v, expected nil", order.ClosedAt)
+	}
+}
+
+func TestOrderDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/orders/1.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Order.Delete(1)
+	if err != nil {
+		t.Errorf("Order.Delete returned error: %v", err)
+	}
+}
+
 func TestOrderListMetafields(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -13,11 +14,21 @@ const pagesResourceName = "pages"
 // See https://help.shopify.com/api/reference/online_store/page
 type PageAPI interface {
 	List(interface{}) ([]Page, error)
+	ListWithContext(context.Context, interface{}) ([]Page, error)
+	ListWithPagination(interface{}) ([]Page, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Page, *Pagination, error)
+	ListAll(interface{}) ([]Page, error)
+	ListAllWithContext(context.Context, interface{}) ([]Page, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Page, error)
+	GetWithContext(context.Context, int, interface{}) (*Page, error)
 	Create(Page) (*Page, error)
+	CreateWithContext(context.Context, Page) (*Page, error)
 	Update(Page) (*Page, error)
+	UpdateWithContext(context.Context, Page) (*Page, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 
 	// MetafieldsAPI used for Pages resource to communicate with Metafields
 	// resource
@@ -57,81 +68,165 @@ type PagesResource struct {
 
 // List pages
 func (s *PageAPIOp) List(options interface{}) ([]Page, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *PageAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Page, error) {
 	path := fmt.Sprintf("%s.json", pagesBasePath)
 	resource := new(PagesResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Pages, err
 }
 
+// ListWithPagination lists pages and returns the pagination to request the
+// next and previous pages.
+func (s *PageAPIOp) ListWithPagination(options interface{}) ([]Page, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *PageAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Page, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", pagesBasePath)
+	return listPage(ctx, s.client, path, options, func(r *PagesResource) []Page { return r.Pages })
+}
+
+// ListAll lists all pages, following the pagination from the page
+// requested by options to the last page.
+func (s *PageAPIOp) ListAll(options interface{}) ([]Page, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *PageAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Page, error) {
+	path := fmt.Sprintf("%s.json", pagesBasePath)
+	return listAll(ctx, s.client, path, options, func(r *PagesResource) []Page { return r.Pages })
+}
+
 // Count pages
 func (s *PageAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *PageAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", pagesBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual page
 func (s *PageAPIOp) Get(pageID int, options interface{}) (*Page, error) {
+	return s.GetWithContext(context.Background(), pageID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *PageAPIOp) GetWithContext(ctx context.Context, pageID int, options interface{}) (*Page, error) {
 	path := fmt.Sprintf("%s/%d.json", pagesBasePath, pageID)
 	resource := new(PageResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Page, err
 }
 
 // Create a new page
 func (s *PageAPIOp) Create(page Page) (*Page, error) {
+	return s.CreateWithContext(context.Background(), page)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *PageAPIOp) CreateWithContext(ctx context.Context, page Page) (*Page, error) {
 	path := fmt.Sprintf("%s.json", pagesBasePath)
 	wrappedData := PageResource{Page: &page}
 	resource := new(PageResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Page, err
 }
 
 // Update an existing page
 func (s *PageAPIOp) Update(page Page) (*Page, error) {
+	return s.UpdateWithContext(context.Background(), page)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *PageAPIOp) UpdateWithContext(ctx context.Context, page Page) (*Page, error) {
 	path := fmt.Sprintf("%s/%d.json", pagesBasePath, page.ID)
 	wrappedData := PageResource{Page: &page}
 	resource := new(PageResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Page, err
 }
 
 // Delete an existing page.
 func (s *PageAPIOp) Delete(pageID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", pagesBasePath, pageID))
+	return s.DeleteWithContext(context.Background(), pageID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *PageAPIOp) DeleteWithContext(ctx context.Context, pageID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", pagesBasePath, pageID))
 }
 
 // ListMetafields list metafields for a page
 func (s *PageAPIOp) ListMetafields(pageID int, options interface{}) ([]Metafield, error) {
+	return s.ListMetafieldsWithContext(context.Background(), pageID, options)
+}
+
+// ListMetafieldsWithContext is the context-aware variant of ListMetafields.
+func (s *PageAPIOp) ListMetafieldsWithContext(ctx context.Context, pageID int, options interface{}) ([]Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
-	return metafieldAPI.List(options)
+	return metafieldAPI.ListWithContext(ctx, options)
 }
 
 // CountMetafields count metafields for a page
 func (s *PageAPIOp) CountMetafields(pageID int, options interface{}) (int, error) {
+	return s.CountMetafieldsWithContext(context.Background(), pageID, options)
+}
+
+// CountMetafieldsWithContext is the context-aware variant of CountMetafields.
+func (s *PageAPIOp) CountMetafieldsWithContext(ctx context.Context, pageID int, options interface{}) (int, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
-	return metafieldAPI.Count(options)
+	return metafieldAPI.CountWithContext(ctx, options)
 }
 
 // GetMetafield get individual metafield for a page
 func (s *PageAPIOp) GetMetafield(pageID int, metafieldID int, options interface{}) (*Metafield, error) {
+	return s.GetMetafieldWithContext(context.Background(), pageID, metafieldID, options)
+}
+
+// GetMetafieldWithContext is the context-aware variant of GetMetafield.
+func (s *PageAPIOp) GetMetafieldWithContext(ctx context.Context, pageID int, metafieldID int, options interface{}) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
-	return metafieldAPI.Get(metafieldID, options)
+	return metafieldAPI.GetWithContext(ctx, metafieldID, options)
 }
 
 // CreateMetafield create a new metafield for a page
 func (s *PageAPIOp) CreateMetafield(pageID int, metafield Metafield) (*Metafield, error) {
+	return s.CreateMetafieldWithContext(context.Background(), pageID, metafield)
+}
+
+// CreateMetafieldWithContext is the context-aware variant of CreateMetafield.
+func (s *PageAPIOp) CreateMetafieldWithContext(ctx context.Context, pageID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
-	return metafieldAPI.Create(metafield)
+	return metafieldAPI.CreateWithContext(ctx, metafield)
 }
 
 // UpdateMetafield update an existing metafield for a page
 func (s *PageAPIOp) UpdateMetafield(pageID int, metafield Metafield) (*Metafield, error) {
+	return s.UpdateMetafieldWithContext(context.Background(), pageID, metafield)
+}
+
+// UpdateMetafieldWithContext is the context-aware variant of UpdateMetafield.
+func (s *PageAPIOp) UpdateMetafieldWithContext(ctx context.Context, pageID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
-	return metafieldAPI.Update(metafield)
+	return metafieldAPI.UpdateWithContext(ctx, metafield)
 }
 
 // DeleteMetafield delete an existing metafield for a page
 func (s *PageAPIOp) DeleteMetafield(pageID int, metafieldID int) error {
+	return s.DeleteMetafieldWithContext(context.Background(), pageID, metafieldID)
+}
+
+// DeleteMetafieldWithContext is the context-aware variant of DeleteMetafield.
+func (s *PageAPIOp) DeleteMetafieldWithContext(ctx context.Context, pageID int, metafieldID int) error {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
-	return metafieldAPI.Delete(metafieldID)
+	return metafieldAPI.DeleteWithContext(ctx, metafieldID)
 }
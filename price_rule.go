@@ -0,0 +1,206 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const priceRulesBasePath = "admin/price_rules"
+
+// PriceRuleAPI is an interface for interfacing with the price rules endpoints
+// of the Shopify API.
+// See: https://help.shopify.com/en/api/reference/discounts/pricerule
+type PriceRuleAPI interface {
+	List(interface{}) ([]PriceRule, error)
+	ListWithContext(context.Context, interface{}) ([]PriceRule, error)
+	ListWithPagination(interface{}) ([]PriceRule, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]PriceRule, *Pagination, error)
+	ListAll(interface{}) ([]PriceRule, error)
+	ListAllWithContext(context.Context, interface{}) ([]PriceRule, error)
+	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
+	Get(int, interface{}) (*PriceRule, error)
+	GetWithContext(context.Context, int, interface{}) (*PriceRule, error)
+	Create(PriceRule) (*PriceRule, error)
+	CreateWithContext(context.Context, PriceRule) (*PriceRule, error)
+	Update(PriceRule) (*PriceRule, error)
+	UpdateWithContext(context.Context, PriceRule) (*PriceRule, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+}
+
+// PriceRuleAPIOp handles communication with the price rule related methods of
+// the Shopify API.
+type PriceRuleAPIOp struct {
+	client *Client
+}
+
+// PriceRule represents a Shopify price rule. A price rule defines the
+// discount, the discount codes created for it only carry the code.
+type PriceRule struct {
+	ID                                     int                                     `json:"id,omitempty"`
+	Title                                  string                                  `json:"title,omitempty"`
+	ValueType                              string                                  `json:"value_type,omitempty"`
+	Value                                  *decimal.Decimal                        `json:"value,omitempty"`
+	CustomerSelection                      string                                  `json:"customer_selection,omitempty"`
+	TargetType                             string                                  `json:"target_type,omitempty"`
+	TargetSelection                        string                                  `json:"target_selection,omitempty"`
+	AllocationMethod                       string                                  `json:"allocation_method,omitempty"`
+	AllocationLimit                        *int                                    `json:"allocation_limit,omitempty"`
+	OncePerCustomer                        *bool                                   `json:"once_per_customer,omitempty"`
+	UsageLimit                             *int                                    `json:"usage_limit,omitempty"`
+	StartsAt                               *time.Time                              `json:"starts_at,omitempty"`
+	EndsAt                                 *time.Time                              `json:"ends_at,omitempty"`
+	CreatedAt                              *time.Time                              `json:"created_at,omitempty"`
+	UpdatedAt                              *time.Time                              `json:"updated_at,omitempty"`
+	EntitledProductIDs                     []int                                   `json:"entitled_product_ids,omitempty"`
+	EntitledVariantIDs                     []int                                   `json:"entitled_variant_ids,omitempty"`
+	EntitledCollectionIDs                  []int                                   `json:"entitled_collection_ids,omitempty"`
+	EntitledCountryIDs                     []int                                   `json:"entitled_country_ids,omitempty"`
+	PrerequisiteProductIDs                 []int                                   `json:"prerequisite_product_ids,omitempty"`
+	PrerequisiteVariantIDs                 []int                                   `json:"prerequisite_variant_ids,omitempty"`
+	PrerequisiteCollectionIDs              []int                                   `json:"prerequisite_collection_ids,omitempty"`
+	PrerequisiteSavedSearchIDs             []int                                   `json:"prerequisite_saved_search_ids,omitempty"`
+	PrerequisiteCustomerIDs                []int                                   `json:"prerequisite_customer_ids,omitempty"`
+	PrerequisiteSubtotalRange              *PrerequisiteSubtotalRange              `json:"prerequisite_subtotal_range,omitempty"`
+	PrerequisiteQuantityRange              *PrerequisiteQuantityRange              `json:"prerequisite_quantity_range,omitempty"`
+	PrerequisiteShippingPriceRange         *PrerequisiteShippingPriceRange         `json:"prerequisite_shipping_price_range,omitempty"`
+	PrerequisiteToEntitlementQuantityRatio *PrerequisiteToEntitlementQuantityRatio `json:"prerequisite_to_entitlement_quantity_ratio,omitempty"`
+}
+
+// PrerequisiteSubtotalRange is the minimum subtotal of the cart for a price
+// rule to apply
+type PrerequisiteSubtotalRange struct {
+	GreaterThanOrEqualTo *decimal.Decimal `json:"greater_than_or_equal_to,omitempty"`
+}
+
+// PrerequisiteQuantityRange is the minimum number of items in the cart for a
+// price rule to apply
+type PrerequisiteQuantityRange struct {
+	GreaterThanOrEqualTo int `json:"greater_than_or_equal_to,omitempty"`
+}
+
+// PrerequisiteShippingPriceRange is the maximum shipping price for a price
+// rule to apply
+type PrerequisiteShippingPriceRange struct {
+	LessThanOrEqualTo *decimal.Decimal `json:"less_than_or_equal_to,omitempty"`
+}
+
+// PrerequisiteToEntitlementQuantityRatio is the "buy X get Y" ratio of a
+// price rule
+type PrerequisiteToEntitlementQuantityRatio struct {
+	PrerequisiteQuantity int `json:"prerequisite_quantity,omitempty"`
+	EntitledQuantity     int `json:"entitled_quantity,omitempty"`
+}
+
+// PriceRuleResource represents the result from the price_rules/X.json endpoint
+type PriceRuleResource struct {
+	PriceRule *PriceRule `json:"price_rule"`
+}
+
+// PriceRulesResource represents the result from the price_rules.json endpoint
+type PriceRulesResource struct {
+	PriceRules []PriceRule `json:"price_rules"`
+}
+
+// List price rules
+func (s *PriceRuleAPIOp) List(options interface{}) ([]PriceRule, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *PriceRuleAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]PriceRule, error) {
+	path := fmt.Sprintf("%s.json", priceRulesBasePath)
+	resource := new(PriceRulesResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.PriceRules, err
+}
+
+// ListWithPagination lists price rules and returns the pagination to request the
+// next and previous pages.
+func (s *PriceRuleAPIOp) ListWithPagination(options interface{}) ([]PriceRule, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *PriceRuleAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]PriceRule, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", priceRulesBasePath)
+	return listPage(ctx, s.client, path, options, func(r *PriceRulesResource) []PriceRule { return r.PriceRules })
+}
+
+// ListAll lists all price rules, following the pagination from the page
+// requested by options to the last page.
+func (s *PriceRuleAPIOp) ListAll(options interface{}) ([]PriceRule, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *PriceRuleAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]PriceRule, error) {
+	path := fmt.Sprintf("%s.json", priceRulesBasePath)
+	return listAll(ctx, s.client, path, options, func(r *PriceRulesResource) []PriceRule { return r.PriceRules })
+}
+
+// Count price rules
+func (s *PriceRuleAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *PriceRuleAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", priceRulesBasePath)
+	return s.client.CountWithContext(ctx, path, options)
+}
+
+// Get individual price rule
+func (s *PriceRuleAPIOp) Get(priceRuleID int, options interface{}) (*PriceRule, error) {
+	return s.GetWithContext(context.Background(), priceRuleID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *PriceRuleAPIOp) GetWithContext(ctx context.Context, priceRuleID int, options interface{}) (*PriceRule, error) {
+	path := fmt.Sprintf("%s/%d.json", priceRulesBasePath, priceRuleID)
+	resource := new(PriceRuleResource)
+	err := s.client.GetWithContext(ctx, path, resource, options)
+	return resource.PriceRule, err
+}
+
+// Create a new price rule
+func (s *PriceRuleAPIOp) Create(priceRule PriceRule) (*PriceRule, error) {
+	return s.CreateWithContext(context.Background(), priceRule)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *PriceRuleAPIOp) CreateWithContext(ctx context.Context, priceRule PriceRule) (*PriceRule, error) {
+	path := fmt.Sprintf("%s.json", priceRulesBasePath)
+	wrappedData := PriceRuleResource{PriceRule: &priceRule}
+	resource := new(PriceRuleResource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
+	return resource.PriceRule, err
+}
+
+// Update an existing price rule
+func (s *PriceRuleAPIOp) Update(priceRule PriceRule) (*PriceRule, error) {
+	return s.UpdateWithContext(context.Background(), priceRule)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *PriceRuleAPIOp) UpdateWithContext(ctx context.Context, priceRule PriceRule) (*PriceRule, error) {
+	path := fmt.Sprintf("%s/%d.json", priceRulesBasePath, priceRule.ID)
+	wrappedData := PriceRuleResource{PriceRule: &priceRule}
+	resource := new(PriceRuleResource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
+	return resource.PriceRule, err
+}
+
+// Delete an existing price rule and its discount codes
+func (s *PriceRuleAPIOp) Delete(priceRuleID int) error {
+	return s.DeleteWithContext(context.Background(), priceRuleID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *PriceRuleAPIOp) DeleteWithContext(ctx context.Context, priceRuleID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", priceRulesBasePath, priceRuleID))
+}
@@ -0,0 +1,178 @@
+package goshopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func priceRuleTests(t *testing.T, priceRule PriceRule) {
+	if priceRule.ID != 507328175 {
+		t.Errorf("PriceRule.ID returned %d, expected %d", priceRule.ID, 507328175)
+	}
+
+	value := decimal.NewFromFloat(-10)
+	if priceRule.Value == nil || !value.Equals(*priceRule.Value) {
+		t.Errorf("PriceRule.Value returned %v, expected %v", priceRule.Value, value)
+	}
+
+	d := time.Date(2019, time.March, 1, 5, 0, 0, 0, time.UTC)
+	if priceRule.StartsAt == nil || !d.Equal(*priceRule.StartsAt) {
+		t.Errorf("PriceRule.StartsAt returned %v, expected %v", priceRule.StartsAt, d)
+	}
+
+	if priceRule.UsageLimit == nil || *priceRule.UsageLimit != 1000 {
+		t.Errorf("PriceRule.UsageLimit returned %v, expected 1000", priceRule.UsageLimit)
+	}
+	if priceRule.AllocationLimit != nil {
+		t.Errorf("PriceRule.AllocationLimit returned %v, expected nil", *priceRule.AllocationLimit)
+	}
+	if priceRule.OncePerCustomer == nil || !*priceRule.OncePerCustomer {
+		t.Errorf("PriceRule.OncePerCustomer returned %v, expected true", priceRule.OncePerCustomer)
+	}
+
+	expectedCollections := []int{841564295}
+	if !reflect.DeepEqual(priceRule.EntitledCollectionIDs, expectedCollections) {
+		t.Errorf("PriceRule.EntitledCollectionIDs returned %v, expected %v", priceRule.EntitledCollectionIDs, expectedCollections)
+	}
+
+	subtotal := decimal.NewFromFloat(40)
+	if priceRule.PrerequisiteSubtotalRange == nil || !subtotal.Equals(*priceRule.PrerequisiteSubtotalRange.GreaterThanOrEqualTo) {
+		t.Errorf("PriceRule.PrerequisiteSubtotalRange returned %+v, expected %v", priceRule.PrerequisiteSubtotalRange, subtotal)
+	}
+	if priceRule.PrerequisiteQuantityRange != nil {
+		t.Errorf("PriceRule.PrerequisiteQuantityRange returned %+v, expected nil", priceRule.PrerequisiteQuantityRange)
+	}
+}
+
+func TestPriceRuleList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules.json",
+		httpmock.NewStringResponder(200, `{"price_rules": [{"id":1},{"id":2}]}`))
+
+	priceRules, err := client.PriceRule.List(nil)
+	if err != nil {
+		t.Errorf("PriceRule.List returned error: %v", err)
+	}
+
+	expected := []PriceRule{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(priceRules, expected) {
+		t.Errorf("PriceRule.List returned %+v, expected %+v", priceRules, expected)
+	}
+}
+
+func TestPriceRuleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/count.json",
+		httpmock.NewStringResponder(200, `{"count": 5}`))
+
+	cnt, err := client.PriceRule.Count(nil)
+	if err != nil {
+		t.Errorf("PriceRule.Count returned error: %v", err)
+	}
+
+	expected := 5
+	if cnt != expected {
+		t.Errorf("PriceRule.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestPriceRuleGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175.json",
+		httpmock.NewBytesResponder(200, loadFixture("price_rule.json")))
+
+	priceRule, err := client.PriceRule.Get(507328175, nil)
+	if err != nil {
+		t.Errorf("PriceRule.Get returned error: %v", err)
+	}
+
+	priceRuleTests(t, *priceRule)
+}
+
+func TestPriceRuleCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/price_rules.json",
+		httpmock.NewBytesResponder(201, loadFixture("price_rule.json")))
+
+	value := decimal.NewFromFloat(-10)
+	subtotal := decimal.NewFromFloat(40)
+	startsAt := time.Date(2019, time.March, 1, 5, 0, 0, 0, time.UTC)
+	priceRule := PriceRule{
+		Title:                 "SPRINGSALE",
+		ValueType:             "percentage",
+		Value:                 &value,
+		CustomerSelection:     "all",
+		TargetType:            "line_item",
+		TargetSelection:       "entitled",
+		AllocationMethod:      "across",
+		StartsAt:              &startsAt,
+		EntitledCollectionIDs: []int{841564295},
+		PrerequisiteSubtotalRange: &PrerequisiteSubtotalRange{
+			GreaterThanOrEqualTo: &subtotal,
+		},
+	}
+
+	returnedPriceRule, err := client.PriceRule.Create(priceRule)
+	if err != nil {
+		t.Errorf("PriceRule.Create returned error: %v", err)
+	}
+
+	priceRuleTests(t, *returnedPriceRule)
+}
+
+func TestPriceRuleUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/price_rules/507328175.json",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"once_per_customer":false`) {
+				t.Errorf("PriceRule.Update sent %s, expected once_per_customer to be false", body)
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("price_rule.json")), nil
+		})
+
+	usageLimit := 1000
+	oncePerCustomer := false
+	priceRule := PriceRule{
+		ID:              507328175,
+		UsageLimit:      &usageLimit,
+		OncePerCustomer: &oncePerCustomer,
+	}
+
+	returnedPriceRule, err := client.PriceRule.Update(priceRule)
+	if err != nil {
+		t.Errorf("PriceRule.Update returned error: %v", err)
+	}
+
+	priceRuleTests(t, *returnedPriceRule)
+}
+
+func TestPriceRuleDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/price_rules/1.json",
+		httpmock.NewStringResponder(204, ""))
+
+	err := client.PriceRule.Delete(1)
+	if err != nil {
+		t.Errorf("PriceRule.Delete returned error: %v", err)
+	}
+}
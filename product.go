@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -13,11 +14,22 @@ const productsResourceName = "products"
 // See: https://help.shopify.com/api/reference/product
 type ProductAPI interface {
 	List(interface{}) ([]Product, error)
+	ListWithContext(context.Context, interface{}) ([]Product, error)
+	ListWithPagination(interface{}) ([]Product, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Product, *Pagination, error)
+	ListAll(interface{}) ([]Product, error)
+	ListAllWithContext(context.Context, interface{}) ([]Product, error)
+	Stream(context.Context, interface{}) (<-chan Product, <-chan error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Product, error)
+	GetWithContext(context.Context, int, interface{}) (*Product, error)
 	Create(Product) (*Product, error)
+	CreateWithContext(context.Context, Product) (*Product, error)
 	Update(Product) (*Product, error)
+	UpdateWithContext(context.Context, Product) (*Product, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 
 	// MetafieldsAPI used for Product resource to communicate with Metafields resource
 	MetafieldsAPI
@@ -74,81 +86,203 @@ type ProductsResource struct {
 
 // List products
 func (s *ProductAPIOp) List(options interface{}) ([]Product, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ProductAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Product, error) {
 	path := fmt.Sprintf("%s.json", productsBasePath)
 	resource := new(ProductsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Products, err
 }
 
+// ListWithPagination lists products and returns the pagination to request the
+// next and previous pages.
+func (s *ProductAPIOp) ListWithPagination(options interface{}) ([]Product, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *ProductAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Product, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", productsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *ProductsResource) []Product { return r.Products })
+}
+
+// ListAll lists all products, following the pagination from the page
+// requested by options to the last page.
+func (s *ProductAPIOp) ListAll(options interface{}) ([]Product, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *ProductAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Product, error) {
+	path := fmt.Sprintf("%s.json", productsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *ProductsResource) []Product { return r.Products })
+}
+
+// Stream lists all products like ListAll, but sends them on the returned
+// channel while the following pages are fetched. Up to 250 products are
+// buffered ahead of the receiver, plus the page being fetched. The error
+// channel receives the error that ended the listing, if any. Both channels
+// are closed when the listing ends.
+func (s *ProductAPIOp) Stream(ctx context.Context, options interface{}) (<-chan Product, <-chan error) {
+	products := make(chan Product, streamBufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(products)
+		defer close(errs)
+
+		path := fmt.Sprintf("%s.json", productsBasePath)
+		pager := s.client.NewPager(path, options)
+		for pager.HasNext() {
+			resource := new(ProductsResource)
+			if err := pager.NextWithContext(ctx, resource); err != nil {
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+				errs <- err
+				return
+			}
+			for _, product := range resource.Products {
+				select {
+				case products <- product:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+
+	return products, errs
+}
+
 // Count products
 func (s *ProductAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *ProductAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", productsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual product
 func (s *ProductAPIOp) Get(productID int, options interface{}) (*Product, error) {
+	return s.GetWithContext(context.Background(), productID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ProductAPIOp) GetWithContext(ctx context.Context, productID int, options interface{}) (*Product, error) {
 	path := fmt.Sprintf("%s/%d.json", productsBasePath, productID)
 	resource := new(ProductResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Product, err
 }
 
 // Create a new product
 func (s *ProductAPIOp) Create(product Product) (*Product, error) {
+	return s.CreateWithContext(context.Background(), product)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ProductAPIOp) CreateWithContext(ctx context.Context, product Product) (*Product, error) {
 	path := fmt.Sprintf("%s.json", productsBasePath)
 	wrappedData := ProductResource{Product: &product}
 	resource := new(ProductResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Product, err
 }
 
 // Update an existing product
 func (s *ProductAPIOp) Update(product Product) (*Product, error) {
+	return s.UpdateWithContext(context.Background(), product)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ProductAPIOp) UpdateWithContext(ctx context.Context, product Product) (*Product, error) {
 	path := fmt.Sprintf("%s/%d.json", productsBasePath, product.ID)
 	wrappedData := ProductResource{Product: &product}
 	resource := new(ProductResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Product, err
 }
 
 // Delete an existing product
 func (s *ProductAPIOp) Delete(productID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", productsBasePath, productID))
+	return s.DeleteWithContext(context.Background(), productID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ProductAPIOp) DeleteWithContext(ctx context.Context, productID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", productsBasePath, productID))
 }
 
 // ListMetafields list metafields for a product
 func (s *ProductAPIOp) ListMetafields(productID int, options interface{}) ([]Metafield, error) {
+	return s.ListMetafieldsWithContext(context.Background(), productID, options)
+}
+
+// ListMetafieldsWithContext is the context-aware variant of ListMetafields.
+func (s *ProductAPIOp) ListMetafieldsWithContext(ctx context.Context, productID int, options interface{}) ([]Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: productsResourceName, resourceID: productID}
-	return metafieldAPI.List(options)
+	return metafieldAPI.ListWithContext(ctx, options)
 }
 
 // CountMetafields count metafields for a product
 func (s *ProductAPIOp) CountMetafields(productID int, options interface{}) (int, error) {
+	return s.CountMetafieldsWithContext(context.Background(), productID, options)
+}
+
+// CountMetafieldsWithContext is the context-aware variant of CountMetafields.
+func (s *ProductAPIOp) CountMetafieldsWithContext(ctx context.Context, productID int, options interface{}) (int, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: productsResourceName, resourceID: productID}
-	return metafieldAPI.Count(options)
+	return metafieldAPI.CountWithContext(ctx, options)
 }
 
 // GetMetafield get individual metafield for a product
 func (s *ProductAPIOp) GetMetafield(productID int, metafieldID int, options interface{}) (*Metafield, error) {
+	return s.GetMetafieldWithContext(context.Background(), productID, metafieldID, options)
+}
+
+// GetMetafieldWithContext is the context-aware variant of GetMetafield.
+func (s *ProductAPIOp) GetMetafieldWithContext(ctx context.Context, productID int, metafieldID int, options interface{}) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: productsResourceName, resourceID: productID}
-	return metafieldAPI.Get(metafieldID, options)
+	return metafieldAPI.GetWithContext(ctx, metafieldID, options)
 }
 
 // CreateMetafield create a new metafield for a product
 func (s *ProductAPIOp) CreateMetafield(productID int, metafield Metafield) (*Metafield, error) {
+	return s.CreateMetafieldWithContext(context.Background(), productID, metafield)
+}
+
+// CreateMetafieldWithContext is the context-aware variant of CreateMetafield.
+func (s *ProductAPIOp) CreateMetafieldWithContext(ctx context.Context, productID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: productsResourceName, resourceID: productID}
-	return metafieldAPI.Create(metafield)
+	return metafieldAPI.CreateWithContext(ctx, metafield)
 }
 
 // UpdateMetafield update an existing metafield for a product
 func (s *ProductAPIOp) UpdateMetafield(productID int, metafield Metafield) (*Metafield, error) {
+	return s.UpdateMetafieldWithContext(context.Background(), productID, metafield)
+}
+
+// UpdateMetafieldWithContext is the context-aware variant of UpdateMetafield.
+func (s *ProductAPIOp) UpdateMetafieldWithContext(ctx context.Context, productID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: productsResourceName, resourceID: productID}
-	return metafieldAPI.Update(metafield)
+	return metafieldAPI.UpdateWithContext(ctx, metafield)
 }
 
 // DeleteMetafield delete an existing metafield for a product
 func (s *ProductAPIOp) DeleteMetafield(productID int, metafieldID int) error {
+	return s.DeleteMetafieldWithContext(context.Background(), productID, metafieldID)
+}
+
+// DeleteMetafieldWithContext is the context-aware variant of DeleteMetafield.
+func (s *ProductAPIOp) DeleteMetafieldWithContext(ctx context.Context, productID int, metafieldID int) error {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: productsResourceName, resourceID: productID}
-	return metafieldAPI.Delete(metafieldID)
+	return metafieldAPI.DeleteWithContext(ctx, metafieldID)
 }
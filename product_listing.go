@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -13,11 +14,21 @@ const productListingsResourceName = "product_listings"
 // See: https://help.shopify.com/en/api/reference/sales-channels/productlisting
 type ProductListingAPI interface {
 	List(interface{}) ([]ProductListing, error)
+	ListWithContext(context.Context, interface{}) ([]ProductListing, error)
+	ListWithPagination(interface{}) ([]ProductListing, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]ProductListing, *Pagination, error)
+	ListAll(interface{}) ([]ProductListing, error)
+	ListAllWithContext(context.Context, interface{}) ([]ProductListing, error)
 	ListProductIDs(interface{}) ([]int, error)
+	ListProductIDsWithContext(context.Context, interface{}) ([]int, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*ProductListing, error)
+	GetWithContext(context.Context, int, interface{}) (*ProductListing, error)
 	Publish(int) (*ProductListing, error)
+	PublishWithContext(context.Context, int) (*ProductListing, error)
 	Unpublish(int) error
+	UnpublishWithContext(context.Context, int) error
 }
 
 // ProductListingAPIOp handles communication with the product related methods of
@@ -71,43 +82,97 @@ type ProductIDsResource struct {
 
 // List product listings
 func (s *ProductListingAPIOp) List(options interface{}) ([]ProductListing, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ProductListingAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]ProductListing, error) {
 	path := fmt.Sprintf("%s.json", productListingsBasePath)
 	resource := new(ProductListingsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.ProductListings, err
 }
 
+// ListWithPagination lists product listings and returns the pagination to request the
+// next and previous pages.
+func (s *ProductListingAPIOp) ListWithPagination(options interface{}) ([]ProductListing, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *ProductListingAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]ProductListing, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", productListingsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *ProductListingsResource) []ProductListing { return r.ProductListings })
+}
+
+// ListAll lists all product listings, following the pagination from the page
+// requested by options to the last page.
+func (s *ProductListingAPIOp) ListAll(options interface{}) ([]ProductListing, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *ProductListingAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]ProductListing, error) {
+	path := fmt.Sprintf("%s.json", productListingsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *ProductListingsResource) []ProductListing { return r.ProductListings })
+}
+
 // ListProductIDs product ids
 func (s *ProductListingAPIOp) ListProductIDs(options interface{}) ([]int, error) {
+	return s.ListProductIDsWithContext(context.Background(), options)
+}
+
+// ListProductIDsWithContext is the context-aware variant of ListProductIDs.
+func (s *ProductListingAPIOp) ListProductIDsWithContext(ctx context.Context, options interface{}) ([]int, error) {
 	path := fmt.Sprintf("%s/product_ids.json", productListingsBasePath)
 	resource := new(ProductIDsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.ProductIDs, err
 }
 
 // Count product listings
 func (s *ProductListingAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *ProductListingAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", productListingsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get a product listing
 func (s *ProductListingAPIOp) Get(productID int, options interface{}) (*ProductListing, error) {
+	return s.GetWithContext(context.Background(), productID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ProductListingAPIOp) GetWithContext(ctx context.Context, productID int, options interface{}) (*ProductListing, error) {
 	path := fmt.Sprintf("%s/%d.json", productListingsBasePath, productID)
 	resource := new(ProductListingResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.ProductListing, err
 }
 
 // Publish a product
 func (s *ProductListingAPIOp) Publish(productID int) (*ProductListing, error) {
+	return s.PublishWithContext(context.Background(), productID)
+}
+
+// PublishWithContext is the context-aware variant of Publish.
+func (s *ProductListingAPIOp) PublishWithContext(ctx context.Context, productID int) (*ProductListing, error) {
 	path := fmt.Sprintf("%s/%d.json", productListingsBasePath, productID)
 	resource := new(ProductListingResource)
-	err := s.client.Put(path, nil, resource)
+	err := s.client.PutWithContext(ctx, path, nil, resource)
 	return resource.ProductListing, err
 }
 
 // Unpublish a product
 func (s *ProductListingAPIOp) Unpublish(productID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", productListingsBasePath, productID))
+	return s.UnpublishWithContext(context.Background(), productID)
+}
+
+// UnpublishWithContext is the context-aware variant of Unpublish.
+func (s *ProductListingAPIOp) UnpublishWithContext(ctx context.Context, productID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", productListingsBasePath, productID))
 }
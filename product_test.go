@@ -1,6 +1,8 @@
 package goshopify
 
 import (
+	"context"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -34,6 +36,33 @@ func TestProductList(t *testing.T) {
 	}
 }
 
+func TestProductStreamCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/products.json",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"products": [{"id":1},{"id":2}]}`)
+			resp.Header.Set("Link", `<https://fooshop.myshopify.com/admin/products.json?page_info=next&limit=2>; rel="next"`)
+			return resp, nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	products, errs := client.Product.Stream(ctx, ListOptions{Limit: 2})
+
+	// The products link to an endless number of pages, stop after the first.
+	for product := range products {
+		if product.ID == 2 {
+			cancel()
+		}
+	}
+
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("Product.Stream returned error %v, expected %v", err, context.Canceled)
+	}
+}
+
 func TestProductListFilterByIDs(t *testing.T) {
 	setup()
 	defer teardown()
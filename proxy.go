@@ -0,0 +1,93 @@
+package goshopify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProxyRequest holds the parameters Shopify adds to the requests it proxies
+// from a shop's storefront to the app.
+type ProxyRequest struct {
+	// Shop is the myshopify.com domain of the shop.
+	Shop string
+
+	// LoggedInCustomerID is the ID of the customer browsing the storefront,
+	// or zero if no customer is logged in.
+	LoggedInCustomerID int
+
+	// PathPrefix is the storefront path the proxy is mounted at, e.g.
+	// "/apps/foo".
+	PathPrefix string
+}
+
+type proxyRequestContextKey struct{}
+
+// VerifyProxyRequest verifies the signature of a request proxied by
+// Shopify's app proxy.
+func (app App) VerifyProxyRequest(httpRequest *http.Request) (bool, error) {
+	query, err := url.ParseQuery(httpRequest.URL.RawQuery)
+	if err != nil {
+		return false, err
+	}
+
+	signature := query.Get("signature")
+	if signature == "" {
+		return false, errors.New("signature not set")
+	}
+	query.Del("signature")
+
+	// The signed message is the sorted parameters joined without a
+	// separator, with the values of repeated parameters joined by commas.
+	params := make([]string, 0, len(query))
+	for key, values := range query {
+		params = append(params, key+"="+strings.Join(values, ","))
+	}
+	sort.Strings(params)
+
+	mac := hmac.New(sha256.New, []byte(app.APISecret))
+	mac.Write([]byte(strings.Join(params, "")))
+	expectedMAC := mac.Sum(nil)
+
+	actualMAC, err := hex.DecodeString(signature)
+	if err != nil {
+		return false, err
+	}
+	return hmac.Equal(actualMAC, expectedMAC), nil
+}
+
+// ProxyMiddleware verifies the requests proxied by Shopify's app proxy and
+// puts their parameters into the request context, see
+// ProxyRequestFromContext. Requests with an invalid signature are rejected
+// with 401 Unauthorized.
+func (app App) ProxyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ok, _ := app.VerifyProxyRequest(r); !ok {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		query := r.URL.Query()
+		proxy := &ProxyRequest{
+			Shop:       query.Get("shop"),
+			PathPrefix: query.Get("path_prefix"),
+		}
+		proxy.LoggedInCustomerID, _ = strconv.Atoi(query.Get("logged_in_customer_id"))
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), proxyRequestContextKey{}, proxy)))
+	})
+}
+
+// ProxyRequestFromContext returns the proxy parameters put into ctx by
+// ProxyMiddleware.
+func ProxyRequestFromContext(ctx context.Context) (*ProxyRequest, bool) {
+	proxy, ok := ctx.Value(proxyRequestContextKey{}).(*ProxyRequest)
+	return proxy, ok
+}
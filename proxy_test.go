@@ -0,0 +1,98 @@
+package goshopify
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// This request is from the Shopify example page:
+// https://help.shopify.com/en/api/guides/application-proxies#calculate-a-digital-signature
+const proxyRequestQuery = "extra=1&extra=2&shop=shop-name.myshopify.com&path_prefix=%2Fapps%2Fawesome_reviews&timestamp=1317327555&signature=a9718877bea71c2484f91608a7eaea1532bdf71f5c56825065fa4ccabe549ef3"
+
+// proxyRequestCustomerQuery is the example request for a logged in customer.
+const proxyRequestCustomerQuery = "extra=1&extra=2&shop=shop-name.myshopify.com&logged_in_customer_id=1&path_prefix=%2Fapps%2Fawesome_reviews&timestamp=1317327555&signature=4c68c8624d737112c91818c11017d24d334b524cb5c2b8ba08daa056f7395ddb"
+
+func TestAppVerifyProxyRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		query    string
+		expected bool
+		err      bool
+	}{
+		{proxyRequestQuery, true, false},
+		{proxyRequestCustomerQuery, true, false},
+		{proxyRequestQuery + "&extra=3", false, false},
+		{"shop=shop-name.myshopify.com&timestamp=1317327555", false, true},
+		{"shop=shop-name.myshopify.com&signature=nothex", false, true},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest("GET", "https://app.example.com/proxy?"+c.query, nil)
+		actual, err := app.VerifyProxyRequest(req)
+		if actual != c.expected {
+			t.Errorf("App.VerifyProxyRequest(%s): expected %v, actual %v", c.query, c.expected, actual)
+		}
+		if (err != nil) != c.err {
+			t.Errorf("App.VerifyProxyRequest(%s) returned error %v, expected error %v", c.query, err, c.err)
+		}
+	}
+}
+
+func TestAppProxyMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var received *ProxyRequest
+	handler := app.ProxyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = ProxyRequestFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest("GET", "https://app.example.com/proxy?"+proxyRequestCustomerQuery, nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("ProxyMiddleware returned status %d, expected %d", w.Code, http.StatusOK)
+	}
+
+	expected := &ProxyRequest{
+		Shop:               "shop-name.myshopify.com",
+		LoggedInCustomerID: 1,
+		PathPrefix:         "/apps/awesome_reviews",
+	}
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("ProxyMiddleware put %+v into the context, expected %+v", received, expected)
+	}
+
+	req = httptest.NewRequest("GET", "https://app.example.com/proxy?"+proxyRequestQuery, nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if received == nil || received.LoggedInCustomerID != 0 {
+		t.Errorf("ProxyMiddleware put %+v into the context, expected no logged in customer", received)
+	}
+}
+
+func TestAppProxyMiddlewareUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+
+	called := false
+	handler := app.ProxyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest("GET", "https://app.example.com/proxy?"+proxyRequestQuery+"&logged_in_customer_id=2", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("ProxyMiddleware returned status %d, expected %d", w.Code, http.StatusUnauthorized)
+	}
+	if called {
+		t.Errorf("ProxyMiddleware called the handler for an invalid signature")
+	}
+}
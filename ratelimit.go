@@ -0,0 +1,341 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// callLimitHeader reports how many calls are in a shop's bucket, e.g. "32/40".
+const callLimitHeader = "X-Shopify-Shop-Api-Call-Limit"
+
+const (
+	defaultBucketCapacity = 40
+	defaultLeakRate       = 2
+)
+
+// RateLimitState is a snapshot of the API call bucket of a shop.
+type RateLimitState struct {
+	// Used is the number of calls currently in the bucket, including calls
+	// that are reserved but not yet answered.
+	Used float64
+	// Capacity is the size of the bucket as last reported by Shopify.
+	Capacity int
+	// LeakRate is the number of calls per second that leak out of the bucket.
+	LeakRate float64
+	// UpdatedAt is the last time the bucket was synchronized with a response.
+	UpdatedAt time.Time
+}
+
+// RateLimiter is a client-side leaky bucket that mirrors Shopify's API call
+// limit. Every request reserves a call in the bucket and waits until the
+// bucket has room for it, so callers slow down before Shopify throttles
+// them. The bucket is synchronized with the X-Shopify-Shop-Api-Call-Limit
+// header of every response. The zero value is a standard bucket like the
+// one NewRateLimiter returns. A RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	mu        sync.Mutex
+	capacity  int
+	leakRate  float64
+	level     float64
+	leakedAt  time.Time
+	updatedAt time.Time
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+}
+
+// NewRateLimiter returns a RateLimiter for a standard Shopify bucket of 40
+// calls leaking 2 calls per second. The capacity is updated from the call
+// limit header, e.g. for Shopify Plus shops.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		capacity: defaultBucketCapacity,
+		leakRate: defaultLeakRate,
+		now:      time.Now,
+	}
+}
+
+// Wait reserves a call in the bucket and blocks until the bucket has room for
+// it or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	err := sleepContext(ctx, l.reserve())
+	if err != nil {
+		l.release()
+	}
+	return err
+}
+
+// Update synchronizes the bucket with the number of used calls and the
+// capacity reported by Shopify. Calls reserved by requests still in flight
+// are kept, so the bucket never drops below what Shopify reported.
+func (l *RateLimiter) Update(used, capacity int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.setDefaults()
+
+	now := l.now()
+	l.leak(now)
+	if capacity > 0 {
+		l.capacity = capacity
+	}
+	if float64(used) > l.level {
+		l.level = float64(used)
+	}
+	l.updatedAt = now
+}
+
+// State returns a snapshot of the bucket.
+func (l *RateLimiter) State() RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.setDefaults()
+
+	l.leak(l.now())
+	return RateLimitState{
+		Used:      l.level,
+		Capacity:  l.capacity,
+		LeakRate:  l.leakRate,
+		UpdatedAt: l.updatedAt,
+	}
+}
+
+// reserve adds a call to the bucket and returns how long the caller has to
+// wait before the call fits into the bucket.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.setDefaults()
+
+	l.leak(l.now())
+	l.level++
+	overflow := l.level - float64(l.capacity)
+	if overflow <= 0 {
+		return 0
+	}
+	return time.Duration(overflow / l.leakRate * float64(time.Second))
+}
+
+// release gives back a call reserved by a request that was never sent.
+func (l *RateLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.setDefaults()
+
+	l.leak(l.now())
+	if l.level >= 1 {
+		l.level--
+	} else {
+		l.level = 0
+	}
+}
+
+// setDefaults makes the zero value a standard bucket. It must be called
+// with l.mu held.
+func (l *RateLimiter) setDefaults() {
+	if l.capacity == 0 {
+		l.capacity = defaultBucketCapacity
+	}
+	if l.leakRate == 0 {
+		l.leakRate = defaultLeakRate
+	}
+	if l.now == nil {
+		l.now = time.Now
+	}
+}
+
+// leak drains the calls that leaked out of the bucket since the last leak.
+// It must be called with l.mu held.
+func (l *RateLimiter) leak(now time.Time) {
+	if !l.leakedAt.IsZero() && now.After(l.leakedAt) {
+		l.level -= now.Sub(l.leakedAt).Seconds() * l.leakRate
+		if l.level < 0 {
+			l.level = 0
+		}
+	}
+	l.leakedAt = now
+}
+
+// parseCallLimit parses a call limit header like "32/40".
+func parseCallLimit(header string) (used, capacity int, err error) {
+	parts := strings.Split(header, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid call limit %q", header)
+	}
+	used, err = strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid call limit %q", header)
+	}
+	capacity, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid call limit %q", header)
+	}
+	return used, capacity, nil
+}
+
+// RateLimitStore keeps the API call buckets of shops. Clients sharing a store
+// draw from one call budget per shop, even across processes when the store
+// is backed by a shared database. Shops are keyed by their ShopFullName.
+// Implementations must be safe for concurrent use.
+type RateLimitStore interface {
+	// Reserve reserves a call in the bucket of shop and returns how long the
+	// caller has to wait before the call fits into the bucket.
+	Reserve(ctx context.Context, shop string) (time.Duration, error)
+
+	// Release gives back a call reserved for a request that was never sent.
+	Release(ctx context.Context, shop string) error
+
+	// Update synchronizes the bucket of shop with the number of used calls
+	// and the capacity reported by Shopify.
+	Update(ctx context.Context, shop string, used, capacity int) error
+
+	// State returns a snapshot of the bucket of shop.
+	State(ctx context.Context, shop string) (RateLimitState, error)
+}
+
+// MemoryRateLimitStore is a RateLimitStore that keeps a RateLimiter per shop
+// in memory. It shares the call budget between clients of one process.
+type MemoryRateLimitStore struct {
+	mu       sync.Mutex
+	limiters map[string]*RateLimiter
+}
+
+// NewMemoryRateLimitStore returns an empty MemoryRateLimitStore.
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{limiters: make(map[string]*RateLimiter)}
+}
+
+// limiter returns the RateLimiter of shop, creating it on first use.
+func (s *MemoryRateLimitStore) limiter(shop string) *RateLimiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.limiters[shop]
+	if !ok {
+		l = NewRateLimiter()
+		s.limiters[shop] = l
+	}
+	return l
+}
+
+// Reserve reserves a call in the bucket of shop.
+func (s *MemoryRateLimitStore) Reserve(ctx context.Context, shop string) (time.Duration, error) {
+	return s.limiter(shop).reserve(), nil
+}
+
+// Release gives back a call reserved in the bucket of shop.
+func (s *MemoryRateLimitStore) Release(ctx context.Context, shop string) error {
+	s.limiter(shop).release()
+	return nil
+}
+
+// Update synchronizes the bucket of shop.
+func (s *MemoryRateLimitStore) Update(ctx context.Context, shop string, used, capacity int) error {
+	s.limiter(shop).Update(used, capacity)
+	return nil
+}
+
+// State returns a snapshot of the bucket of shop.
+func (s *MemoryRateLimitStore) State(ctx context.Context, shop string) (RateLimitState, error) {
+	return s.limiter(shop).State(), nil
+}
+
+// singleRateLimitStore adapts a RateLimiter to a RateLimitStore holding the
+// bucket of a single shop.
+type singleRateLimitStore struct {
+	limiter *RateLimiter
+}
+
+func (s singleRateLimitStore) Reserve(ctx context.Context, shop string) (time.Duration, error) {
+	return s.limiter.reserve(), nil
+}
+
+func (s singleRateLimitStore) Release(ctx context.Context, shop string) error {
+	s.limiter.release()
+	return nil
+}
+
+func (s singleRateLimitStore) Update(ctx context.Context, shop string, used, capacity int) error {
+	s.limiter.Update(used, capacity)
+	return nil
+}
+
+func (s singleRateLimitStore) State(ctx context.Context, shop string) (RateLimitState, error) {
+	return s.limiter.State(), nil
+}
+
+// rateLimitStore returns the store used to throttle the client's requests,
+// or nil if requests are not throttled. RateLimitStore takes precedence over
+// RateLimiter.
+func (c *Client) rateLimitStore() RateLimitStore {
+	if c.RateLimitStore != nil {
+		return c.RateLimitStore
+	}
+	if c.RateLimiter != nil {
+		return singleRateLimitStore{limiter: c.RateLimiter}
+	}
+	return nil
+}
+
+// RateLimitState returns a snapshot of the API call bucket of the client's
+// shop. It returns an error if the client does not throttle requests.
+func (c *Client) RateLimitState() (RateLimitState, error) {
+	store := c.rateLimitStore()
+	if store == nil {
+		return RateLimitState{}, errors.New("client has no rate limiter")
+	}
+	return store.State(context.Background(), c.shopName)
+}
+
+// waitForRateLimit reserves a call in the bucket of the client's shop and
+// blocks until the bucket has room for it or ctx is done.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	store := c.rateLimitStore()
+	if store == nil {
+		return nil
+	}
+
+	wait, err := store.Reserve(ctx, c.shopName)
+	if err != nil {
+		return err
+	}
+
+	err = sleepContext(ctx, wait)
+	if err != nil {
+		store.Release(context.Background(), c.shopName)
+	}
+	return err
+}
+
+// updateRateLimit synchronizes the bucket of the client's shop with the call
+// limit header of the response, if it has one. A throttled response means
+// the bucket is full. Errors of the store are ignored, the response itself
+// has been received successfully.
+func (c *Client) updateRateLimit(ctx context.Context, resp *http.Response) {
+	store := c.rateLimitStore()
+	if store == nil {
+		return
+	}
+
+	throttled := resp.StatusCode == http.StatusTooManyRequests
+	used, capacity, err := parseCallLimit(resp.Header.Get(callLimitHeader))
+	if err != nil {
+		if !throttled {
+			return
+		}
+		state, err := store.State(ctx, c.shopName)
+		if err != nil {
+			return
+		}
+		capacity = state.Capacity
+	}
+	if throttled {
+		used = capacity
+	}
+	store.Update(ctx, c.shopName, used, capacity)
+}
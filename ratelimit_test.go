@@ -0,0 +1,264 @@
+package goshopify
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+// fakeClock is a manually advanced clock for rate limiter tests.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newTestRateLimiter() (*RateLimiter, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	l := NewRateLimiter()
+	l.now = clock.now
+	return l, clock
+}
+
+func TestParseCallLimit(t *testing.T) {
+	cases := []struct {
+		header   string
+		used     int
+		capacity int
+		valid    bool
+	}{
+		{"32/40", 32, 40, true},
+		{" 1/80 ", 1, 80, true},
+		{"", 0, 0, false},
+		{"32", 0, 0, false},
+		{"a/40", 0, 0, false},
+		{"32/b", 0, 0, false},
+	}
+
+	for _, c := range cases {
+		used, capacity, err := parseCallLimit(c.header)
+		if (err == nil) != c.valid {
+			t.Errorf("parseCallLimit(%q) err = %v, expected valid %v", c.header, err, c.valid)
+		}
+		if used != c.used || capacity != c.capacity {
+			t.Errorf("parseCallLimit(%q) = %d, %d, expected %d, %d", c.header, used, capacity, c.used, c.capacity)
+		}
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	l, clock := newTestRateLimiter()
+
+	l.Update(39, 40)
+	if wait := l.reserve(); wait != 0 {
+		t.Errorf("reserve() with room in the bucket = %v, expected 0", wait)
+	}
+
+	// The bucket is full, the next call has to wait for one call to leak.
+	if wait := l.reserve(); wait != 500*time.Millisecond {
+		t.Errorf("reserve() with a full bucket = %v, expected %v", wait, 500*time.Millisecond)
+	}
+
+	// Callers queue up behind each other.
+	if wait := l.reserve(); wait != time.Second {
+		t.Errorf("reserve() behind a waiting call = %v, expected %v", wait, time.Second)
+	}
+
+	clock.advance(10 * time.Second)
+	state := l.State()
+	if state.Used != 22 {
+		t.Errorf("State().Used after leaking = %v, expected 22", state.Used)
+	}
+	if !state.UpdatedAt.Equal(clock.t.Add(-10 * time.Second)) {
+		t.Errorf("State().UpdatedAt = %v, expected %v", state.UpdatedAt, clock.t.Add(-10*time.Second))
+	}
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	l, _ := newTestRateLimiter()
+
+	l.reserve()
+	l.reserve()
+	l.Update(1, 80)
+
+	state := l.State()
+	if state.Used != 2 {
+		t.Errorf("State().Used = %v, expected reserved calls to be kept", state.Used)
+	}
+	if state.Capacity != 80 {
+		t.Errorf("State().Capacity = %v, expected 80", state.Capacity)
+	}
+
+	l.Update(60, 80)
+	if used := l.State().Used; used != 60 {
+		t.Errorf("State().Used = %v, expected 60", used)
+	}
+}
+
+func TestRateLimiterZeroValue(t *testing.T) {
+	var l RateLimiter
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() on the zero value returned error: %v", err)
+	}
+
+	state := l.State()
+	if state.Used <= 0 || state.Used > 1 || state.Capacity != 40 || state.LeakRate != 2 {
+		t.Errorf("State() of the zero value = %+v, expected a standard bucket with about 1 call", state)
+	}
+
+	l.Update(40, 40)
+	if wait := l.reserve(); wait <= 0 || wait > 500*time.Millisecond {
+		t.Errorf("reserve() with a full bucket = %v, expected at most %v", wait, 500*time.Millisecond)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l, _ := newTestRateLimiter()
+	l.Update(40, 40)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait() err = %v, expected %v", err, context.Canceled)
+	}
+	if used := l.State().Used; used != 40 {
+		t.Errorf("State().Used = %v, expected the canceled reservation to be released", used)
+	}
+}
+
+func TestRateLimiterConcurrent(t *testing.T) {
+	l := NewRateLimiter()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Wait(context.Background()); err != nil {
+				t.Errorf("Wait() err = %v", err)
+			}
+			l.Update(1, 40)
+		}()
+	}
+	wg.Wait()
+
+	if used := l.State().Used; used > 20 {
+		t.Errorf("State().Used = %v, expected at most 20", used)
+	}
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RateLimiter = NewRateLimiter()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{}`)
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "32/80")
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/throttled",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(429, `{"errors":"Exceeded 2 calls per second for api client."}`), nil
+		})
+
+	if err := client.Get("foo", nil, nil); err != nil {
+		t.Fatalf("Client.Get returned error: %v", err)
+	}
+
+	state := client.RateLimiter.State()
+	if state.Used < 31 || state.Used > 32 {
+		t.Errorf("RateLimiter.State().Used = %v, expected 32", state.Used)
+	}
+	if state.Capacity != 80 {
+		t.Errorf("RateLimiter.State().Capacity = %v, expected 80", state.Capacity)
+	}
+
+	if err := client.Get("throttled", nil, nil); err == nil {
+		t.Fatalf("Client.Get returned nil error, expected RateLimitError")
+	}
+	if used := client.RateLimiter.State().Used; used < 79 {
+		t.Errorf("RateLimiter.State().Used after throttling = %v, expected 80", used)
+	}
+}
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	store := NewMemoryRateLimitStore()
+	ctx := context.Background()
+
+	if err := store.Update(ctx, "fooshop.myshopify.com", 39, 40); err != nil {
+		t.Fatalf("MemoryRateLimitStore.Update returned error: %v", err)
+	}
+
+	wait, err := store.Reserve(ctx, "fooshop.myshopify.com")
+	if err != nil || wait != 0 {
+		t.Errorf("MemoryRateLimitStore.Reserve = %v, %v, expected 0, nil", wait, err)
+	}
+	wait, _ = store.Reserve(ctx, "fooshop.myshopify.com")
+	if wait <= 0 {
+		t.Errorf("MemoryRateLimitStore.Reserve on a full bucket = %v, expected to wait", wait)
+	}
+	store.Release(ctx, "fooshop.myshopify.com")
+
+	// Other shops have their own bucket.
+	wait, _ = store.Reserve(ctx, "barshop.myshopify.com")
+	if wait != 0 {
+		t.Errorf("MemoryRateLimitStore.Reserve for another shop = %v, expected 0", wait)
+	}
+
+	state, err := store.State(ctx, "barshop.myshopify.com")
+	if err != nil {
+		t.Fatalf("MemoryRateLimitStore.State returned error: %v", err)
+	}
+	if state.Capacity != 40 || state.Used > 1 {
+		t.Errorf("MemoryRateLimitStore.State = %+v, expected 1/40", state)
+	}
+}
+
+func TestClientRateLimitStoreShared(t *testing.T) {
+	setup()
+	defer teardown()
+
+	store := NewMemoryRateLimitStore()
+	client.RateLimitStore = store
+
+	other := mustNewClient(app, "fooshop.myshopify.com", "abcd")
+	other.RateLimitStore = store
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{}`)
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "30/40")
+			return resp, nil
+		})
+
+	if err := client.Get("foo", nil, nil); err != nil {
+		t.Fatalf("Client.Get returned error: %v", err)
+	}
+
+	state, err := other.RateLimitState()
+	if err != nil {
+		t.Fatalf("Client.RateLimitState returned error: %v", err)
+	}
+	if state.Used < 29 || state.Used > 30 {
+		t.Errorf("Client.RateLimitState().Used = %v, expected the other client's 30 calls", state.Used)
+	}
+
+	if _, err := mustNewClient(app, "fooshop", "abcd").RateLimitState(); err == nil {
+		t.Errorf("Client.RateLimitState without a rate limiter returned nil error")
+	}
+}
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -15,11 +16,17 @@ const recurringApplicationChargesBasePath = "admin/recurring_application_charges
 // See https://help.shopify.com/api/reference/billing/recurringapplicationcharge
 type RecurringApplicationChargeAPI interface {
 	Create(RecurringApplicationCharge) (*RecurringApplicationCharge, error)
+	CreateWithContext(context.Context, RecurringApplicationCharge) (*RecurringApplicationCharge, error)
 	Get(int, interface{}) (*RecurringApplicationCharge, error)
+	GetWithContext(context.Context, int, interface{}) (*RecurringApplicationCharge, error)
 	List(interface{}) ([]RecurringApplicationCharge, error)
+	ListWithContext(context.Context, interface{}) ([]RecurringApplicationCharge, error)
 	Activate(RecurringApplicationCharge) (*RecurringApplicationCharge, error)
+	ActivateWithContext(context.Context, RecurringApplicationCharge) (*RecurringApplicationCharge, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 	Update(int, int) (*RecurringApplicationCharge, error)
+	UpdateWithContext(context.Context, int, int) (*RecurringApplicationCharge, error)
 }
 
 // RecurringApplicationChargeAPIOp handles communication with the
@@ -129,10 +136,17 @@ type RecurringApplicationChargesResource struct {
 func (r *RecurringApplicationChargeAPIOp) Create(charge RecurringApplicationCharge) (
 	*RecurringApplicationCharge, error) {
 
+	return r.CreateWithContext(context.Background(), charge)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (r *RecurringApplicationChargeAPIOp) CreateWithContext(ctx context.Context, charge RecurringApplicationCharge) (
+	*RecurringApplicationCharge, error) {
+
 	path := fmt.Sprintf("%s.json", recurringApplicationChargesBasePath)
 	wrappedData := RecurringApplicationChargeResource{Charge: &charge}
 	resource := &RecurringApplicationChargeResource{}
-	err := r.client.Post(path, wrappedData, resource)
+	err := r.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Charge, err
 }
 
@@ -140,9 +154,16 @@ func (r *RecurringApplicationChargeAPIOp) Create(charge RecurringApplicationChar
 func (r *RecurringApplicationChargeAPIOp) Get(chargeID int, options interface{}) (
 	*RecurringApplicationCharge, error) {
 
+	return r.GetWithContext(context.Background(), chargeID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (r *RecurringApplicationChargeAPIOp) GetWithContext(ctx context.Context, chargeID int, options interface{}) (
+	*RecurringApplicationCharge, error) {
+
 	path := fmt.Sprintf("%s/%d.json", recurringApplicationChargesBasePath, chargeID)
 	resource := &RecurringApplicationChargeResource{}
-	err := r.client.Get(path, resource, options)
+	err := r.client.GetWithContext(ctx, path, resource, options)
 	return resource.Charge, err
 }
 
@@ -150,9 +171,16 @@ func (r *RecurringApplicationChargeAPIOp) Get(chargeID int, options interface{})
 func (r *RecurringApplicationChargeAPIOp) List(options interface{}) (
 	[]RecurringApplicationCharge, error) {
 
+	return r.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (r *RecurringApplicationChargeAPIOp) ListWithContext(ctx context.Context, options interface{}) (
+	[]RecurringApplicationCharge, error) {
+
 	path := fmt.Sprintf("%s.json", recurringApplicationChargesBasePath)
 	resource := &RecurringApplicationChargesResource{}
-	err := r.client.Get(path, resource, options)
+	err := r.client.GetWithContext(ctx, path, resource, options)
 	return resource.Charges, err
 }
 
@@ -160,25 +188,44 @@ func (r *RecurringApplicationChargeAPIOp) List(options interface{}) (
 func (r *RecurringApplicationChargeAPIOp) Activate(charge RecurringApplicationCharge) (
 	*RecurringApplicationCharge, error) {
 
+	return r.ActivateWithContext(context.Background(), charge)
+}
+
+// ActivateWithContext is the context-aware variant of Activate.
+func (r *RecurringApplicationChargeAPIOp) ActivateWithContext(ctx context.Context, charge RecurringApplicationCharge) (
+	*RecurringApplicationCharge, error) {
+
 	path := fmt.Sprintf("%s/%d/activate.json", recurringApplicationChargesBasePath, charge.ID)
 	wrappedData := RecurringApplicationChargeResource{Charge: &charge}
 	resource := &RecurringApplicationChargeResource{}
-	err := r.client.Post(path, wrappedData, resource)
+	err := r.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Charge, err
 }
 
 // Delete deletes recurring application charge.
 func (r *RecurringApplicationChargeAPIOp) Delete(chargeID int) error {
-	return r.client.Delete(fmt.Sprintf("%s/%d.json", recurringApplicationChargesBasePath, chargeID))
+	return r.DeleteWithContext(context.Background(), chargeID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (r *RecurringApplicationChargeAPIOp) DeleteWithContext(ctx context.Context, chargeID int) error {
+	return r.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", recurringApplicationChargesBasePath, chargeID))
 }
 
 // Update updates recurring application charge.
 func (r *RecurringApplicationChargeAPIOp) Update(chargeID, newCappedAmount int) (
 	*RecurringApplicationCharge, error) {
 
+	return r.UpdateWithContext(context.Background(), chargeID, newCappedAmount)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (r *RecurringApplicationChargeAPIOp) UpdateWithContext(ctx context.Context, chargeID, newCappedAmount int) (
+	*RecurringApplicationCharge, error) {
+
 	path := fmt.Sprintf("%s/%d/customize.json?recurring_application_charge[capped_amount]=%d",
 		recurringApplicationChargesBasePath, chargeID, newCappedAmount)
 	resource := &RecurringApplicationChargeResource{}
-	err := r.client.Put(path, nil, resource)
+	err := r.client.PutWithContext(ctx, path, nil, resource)
 	return resource.Charge, err
 }
@@ -0,0 +1,99 @@
+package goshopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// RedactedHeaders are the headers carrying credentials. They are redacted
+// from debug dumps and from the cassettes of the goshopifytest package.
+var RedactedHeaders = []string{
+	"X-Shopify-Access-Token",
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// RedactedFields are the JSON fields holding credentials and the personal
+// data of customers, orders and addresses. They are redacted from debug
+// dumps and from the cassettes of the goshopifytest package.
+var RedactedFields = []string{
+	"access_token",
+	"address1",
+	"address2",
+	"browser_ip",
+	"client_secret",
+	"company",
+	"contact_email",
+	"email",
+	"first_name",
+	"last_name",
+	"latitude",
+	"longitude",
+	"phone",
+	"zip",
+}
+
+// addressFields are the JSON fields holding addresses, whose name is a
+// person's name rather than a resource name.
+var addressFields = map[string]bool{
+	"addresses":        true,
+	"billing_address":  true,
+	"customer_address": true,
+	"default_address":  true,
+	"shipping_address": true,
+}
+
+// RedactHeader returns a copy of header with the values of RedactedHeaders
+// replaced by replacement.
+func RedactHeader(header http.Header, replacement string) http.Header {
+	redacted := make(http.Header, len(header))
+	for key, values := range header {
+		redacted[key] = append([]string(nil), values...)
+	}
+	for _, key := range RedactedHeaders {
+		if _, ok := redacted[key]; ok {
+			redacted[key] = []string{replacement}
+		}
+	}
+	return redacted
+}
+
+// RedactJSON returns the JSON body with the values of fields, wherever they
+// are nested, and the names in addresses replaced by the result of replace.
+// An error is returned if body is not JSON, which callers can handle by
+// keeping or dropping the body.
+func RedactJSON(body []byte, fields []string, replace func(value interface{}) interface{}) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	redacted := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		redacted[field] = true
+	}
+	return json.Marshal(redactValue(v, redacted, replace, false))
+}
+
+// redactValue redacts fields from a decoded JSON value in place.
+func redactValue(v interface{}, fields map[string]bool, replace func(interface{}) interface{}, address bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if fields[key] || (address && key == "name") {
+				v[key] = replace(value)
+				continue
+			}
+			v[key] = redactValue(value, fields, replace, addressFields[key])
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value, fields, replace, address)
+		}
+	}
+	return v
+}
@@ -0,0 +1,61 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRedactHeader(t *testing.T) {
+	header := http.Header{
+		"X-Shopify-Access-Token": {"secret"},
+		"Content-Type":           {"application/json"},
+	}
+
+	redacted := RedactHeader(header, "x")
+	expected := http.Header{
+		"X-Shopify-Access-Token": {"x"},
+		"Content-Type":           {"application/json"},
+	}
+	if !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("RedactHeader returned %v, expected %v", redacted, expected)
+	}
+	if header.Get("X-Shopify-Access-Token") != "secret" {
+		t.Errorf("RedactHeader modified the header it was given")
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	zero := func(value interface{}) interface{} {
+		if _, ok := value.(json.Number); ok {
+			return json.Number("0")
+		}
+		return "x"
+	}
+
+	cases := []struct {
+		fields   []string
+		body     string
+		expected string
+	}{
+		{RedactedFields, `{"customers":[{"id":1,"email":"bob@example.com"}]}`, `{"customers":[{"email":"x","id":1}]}`},
+		{RedactedFields, `{"shipping_address":{"name":"Bob","latitude":45.41,"city":"Ottawa"}}`, `{"shipping_address":{"city":"Ottawa","latitude":0,"name":"x"}}`},
+		{RedactedFields, `{"product":{"name":"Shirt"}}`, `{"product":{"name":"Shirt"}}`},
+		{[]string{"code"}, `{"code":"abc","email":"bob@example.com"}`, `{"code":"x","email":"bob@example.com"}`},
+	}
+
+	for _, c := range cases {
+		actual, err := RedactJSON([]byte(c.body), c.fields, zero)
+		if err != nil {
+			t.Errorf("RedactJSON(%s) returned error: %v", c.body, err)
+		}
+		if string(actual) != c.expected {
+			t.Errorf("RedactJSON(%s) = %s, expected %s", c.body, actual, c.expected)
+		}
+	}
+
+	if _, err := RedactJSON([]byte(`not json`), RedactedFields, zero); err == nil {
+		t.Errorf("RedactJSON of a body that is not JSON returned nil error")
+	}
+}
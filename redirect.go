@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -11,11 +12,21 @@ const redirectsBasePath = "admin/redirects"
 // See https://help.shopify.com/api/reference/online_store/redirect
 type RedirectAPI interface {
 	List(interface{}) ([]Redirect, error)
+	ListWithContext(context.Context, interface{}) ([]Redirect, error)
+	ListWithPagination(interface{}) ([]Redirect, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Redirect, *Pagination, error)
+	ListAll(interface{}) ([]Redirect, error)
+	ListAllWithContext(context.Context, interface{}) ([]Redirect, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Redirect, error)
+	GetWithContext(context.Context, int, interface{}) (*Redirect, error)
 	Create(Redirect) (*Redirect, error)
+	CreateWithContext(context.Context, Redirect) (*Redirect, error)
 	Update(Redirect) (*Redirect, error)
+	UpdateWithContext(context.Context, Redirect) (*Redirect, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 }
 
 // RedirectAPIOp handles communication with the redirect related methods of the
@@ -43,45 +54,99 @@ type RedirectsResource struct {
 
 // List redirects
 func (s *RedirectAPIOp) List(options interface{}) ([]Redirect, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *RedirectAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Redirect, error) {
 	path := fmt.Sprintf("%s.json", redirectsBasePath)
 	resource := new(RedirectsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Redirects, err
 }
 
+// ListWithPagination lists redirects and returns the pagination to request the
+// next and previous pages.
+func (s *RedirectAPIOp) ListWithPagination(options interface{}) ([]Redirect, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *RedirectAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Redirect, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", redirectsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *RedirectsResource) []Redirect { return r.Redirects })
+}
+
+// ListAll lists all redirects, following the pagination from the page
+// requested by options to the last page.
+func (s *RedirectAPIOp) ListAll(options interface{}) ([]Redirect, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *RedirectAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Redirect, error) {
+	path := fmt.Sprintf("%s.json", redirectsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *RedirectsResource) []Redirect { return r.Redirects })
+}
+
 // Count redirects
 func (s *RedirectAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *RedirectAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", redirectsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual redirect
 func (s *RedirectAPIOp) Get(redirectID int, options interface{}) (*Redirect, error) {
+	return s.GetWithContext(context.Background(), redirectID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *RedirectAPIOp) GetWithContext(ctx context.Context, redirectID int, options interface{}) (*Redirect, error) {
 	path := fmt.Sprintf("%s/%d.json", redirectsBasePath, redirectID)
 	resource := new(RedirectResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Redirect, err
 }
 
 // Create a new redirect
 func (s *RedirectAPIOp) Create(redirect Redirect) (*Redirect, error) {
+	return s.CreateWithContext(context.Background(), redirect)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *RedirectAPIOp) CreateWithContext(ctx context.Context, redirect Redirect) (*Redirect, error) {
 	path := fmt.Sprintf("%s.json", redirectsBasePath)
 	wrappedData := RedirectResource{Redirect: &redirect}
 	resource := new(RedirectResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Redirect, err
 }
 
 // Update an existing redirect
 func (s *RedirectAPIOp) Update(redirect Redirect) (*Redirect, error) {
+	return s.UpdateWithContext(context.Background(), redirect)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *RedirectAPIOp) UpdateWithContext(ctx context.Context, redirect Redirect) (*Redirect, error) {
 	path := fmt.Sprintf("%s/%d.json", redirectsBasePath, redirect.ID)
 	wrappedData := RedirectResource{Redirect: &redirect}
 	resource := new(RedirectResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Redirect, err
 }
 
 // Delete an existing redirect.
 func (s *RedirectAPIOp) Delete(redirectID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", redirectsBasePath, redirectID))
+	return s.DeleteWithContext(context.Background(), redirectID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *RedirectAPIOp) DeleteWithContext(ctx context.Context, redirectID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", redirectsBasePath, redirectID))
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -12,9 +13,13 @@ import (
 // https://help.shopify.com/api/reference/orders/refund
 type RefundAPI interface {
 	List(interface{}) ([]Refund, error)
+	ListWithContext(context.Context, interface{}) ([]Refund, error)
 	Get(int, interface{}) (*Refund, error)
+	GetWithContext(context.Context, int, interface{}) (*Refund, error)
 	Calculate(Refund) (*Refund, error)
+	CalculateWithContext(context.Context, Refund) (*Refund, error)
 	Create(Refund) (*Refund, error)
+	CreateWithContext(context.Context, Refund) (*Refund, error)
 }
 
 // RefundsAPI is an interface for other Shopify resources
@@ -22,9 +27,13 @@ type RefundAPI interface {
 // https://help.shopify.com/api/reference/orders/refund
 type RefundsAPI interface {
 	ListRefunds(int, interface{}) ([]Refund, error)
+	ListRefundsWithContext(context.Context, int, interface{}) ([]Refund, error)
 	GetRefund(int, int, interface{}) (*Refund, error)
+	GetRefundWithContext(context.Context, int, int, interface{}) (*Refund, error)
 	CalculateRefund(int, Refund) (*Refund, error)
+	CalculateRefundWithContext(context.Context, int, Refund) (*Refund, error)
 	CreateRefund(int, Refund) (*Refund, error)
+	CreateRefundWithContext(context.Context, int, Refund) (*Refund, error)
 }
 
 // RefundAPIOp handles communication with the refund
@@ -88,38 +97,58 @@ type RefundsResource struct {
 
 // List refunds
 func (s *RefundAPIOp) List(options interface{}) ([]Refund, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *RefundAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Refund, error) {
 	prefix := RefundPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s.json", prefix)
 	resource := new(RefundsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Refunds, err
 }
 
 // Get individual fulfillment
 func (s *RefundAPIOp) Get(fulfillmentID int, options interface{}) (*Refund, error) {
+	return s.GetWithContext(context.Background(), fulfillmentID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *RefundAPIOp) GetWithContext(ctx context.Context, fulfillmentID int, options interface{}) (*Refund, error) {
 	prefix := RefundPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/%d.json", prefix, fulfillmentID)
 	resource := new(RefundResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Refund, err
 }
 
 // Calculate a new refund
 func (s *RefundAPIOp) Calculate(refund Refund) (*Refund, error) {
+	return s.CalculateWithContext(context.Background(), refund)
+}
+
+// CalculateWithContext is the context-aware variant of Calculate.
+func (s *RefundAPIOp) CalculateWithContext(ctx context.Context, refund Refund) (*Refund, error) {
 	prefix := RefundPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s/calculate.json", prefix)
 	wrappedData := RefundResource{Refund: &refund}
 	resource := new(RefundResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Refund, err
 }
 
 // Create a new refund
 func (s *RefundAPIOp) Create(refund Refund) (*Refund, error) {
+	return s.CreateWithContext(context.Background(), refund)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *RefundAPIOp) CreateWithContext(ctx context.Context, refund Refund) (*Refund, error) {
 	prefix := RefundPathPrefix(s.resource, s.resourceID)
 	path := fmt.Sprintf("%s.json", prefix)
 	wrappedData := RefundResource{Refund: &refund}
 	resource := new(RefundResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Refund, err
 }
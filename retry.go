@@ -0,0 +1,218 @@
+package goshopify
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures how the Client retries failed requests. Rate
+// limited requests wait for the duration Shopify asks for in the Retry-After
+// header, all other retries back off exponentially with jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one.
+	MaxAttempts int
+
+	// MinBackoff is the wait before the first retry. It doubles for every
+	// following retry until MaxBackoff is reached.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryStatuses lists the response status codes that are retried.
+	RetryStatuses []int
+
+	// RetryConnectionErrors retries requests whose connection was reset or
+	// closed before a response was received.
+	RetryConnectionErrors bool
+
+	// RetryMethods lists the request methods whose server errors and
+	// connection errors are retried. Shopify may have applied such a request
+	// before it failed, so only the idempotent methods GET, HEAD, PUT and
+	// DELETE are retried when it is empty. Rate limited requests were not
+	// applied and are retried for all methods.
+	RetryMethods []string
+
+	// OnRetry, if set, is called before waiting for every retry.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a failed attempt that is about to be retried.
+type RetryEvent struct {
+	Request *http.Request
+	// Attempt is the number of the attempt that failed, starting at 1.
+	Attempt int
+	// Wait is how long the client waits before the next attempt.
+	Wait time.Duration
+	// Err is the error returned by the failed attempt.
+	Err error
+}
+
+// DefaultRetryPolicy returns a policy that makes up to 3 attempts and retries
+// rate limited requests, and server errors and reset connections of
+// idempotent requests.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:           3,
+		MinBackoff:            500 * time.Millisecond,
+		MaxBackoff:            30 * time.Second,
+		RetryStatuses:         []int{429, 500, 502, 503, 504},
+		RetryConnectionErrors: true,
+	}
+}
+
+// do calls send until it succeeds, returns an error that should not be
+// retried or the attempts are exhausted. The request body is buffered so
+// that it can be sent again. Retries are logged to logger if it is not nil.
+func (p *RetryPolicy) do(req *http.Request, logger Logger, send func(*http.Request) error) error {
+	if err := rewindableBody(req); err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := send(req)
+		if err == nil || attempt >= p.MaxAttempts || !p.shouldRetry(req.Method, err) {
+			return err
+		}
+
+		wait := p.backoff(attempt, err)
+		if logger != nil {
+			logger.Warn("shopify: retrying request", "method", req.Method, "path", req.URL.Path, "attempt", attempt, "wait", wait, "error", err)
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(RetryEvent{Request: req, Attempt: attempt, Wait: wait, Err: err})
+		}
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return err
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// idempotentMethods are the request methods retried when RetryMethods is
+// empty.
+var idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete}
+
+// shouldRetry reports whether a request with the method that failed with err
+// is retried.
+func (p *RetryPolicy) shouldRetry(method string, err error) bool {
+	if status := errorStatus(err); status != 0 {
+		for _, s := range p.RetryStatuses {
+			if s == status {
+				return status == http.StatusTooManyRequests || p.retryMethod(method)
+			}
+		}
+		return false
+	}
+	return p.RetryConnectionErrors && p.retryMethod(method) && isConnectionError(err)
+}
+
+// retryMethod reports whether failed requests with the method may be sent
+// again.
+func (p *RetryPolicy) retryMethod(method string) bool {
+	methods := p.RetryMethods
+	if len(methods) == 0 {
+		methods = idempotentMethods
+	}
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait after the given failed attempt. The
+// Retry-After duration of a rate limited response takes precedence.
+func (p *RetryPolicy) backoff(attempt int, err error) time.Duration {
+	if e, ok := err.(RateLimitError); ok && e.RetryAfter > 0 {
+		return time.Duration(e.RetryAfter) * time.Second
+	}
+
+	wait := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Wait at least half of the backoff, the rest is random so that clients
+	// throttled at the same time do not retry in lockstep.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+// rewindableBody makes sure the body of req can be read again for a retry.
+func rewindableBody(req *http.Request) error {
+	if req.Body == nil || req.GetBody != nil {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
+// errorStatus returns the response status code carried by err, or 0 if err
+// did not come from a response.
+func errorStatus(err error) int {
+	switch e := err.(type) {
+	case RateLimitError:
+		return e.Status
+	case ResponseError:
+		return e.Status
+	case ResponseDecodingError:
+		return e.Status
+	}
+	return 0
+}
+
+// isConnectionError reports whether err is caused by a connection that was
+// reset or closed before the response was read. Only transport errors count,
+// a truncated body of a successful response is not retried.
+func isConnectionError(err error) bool {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return false
+	}
+
+	cause := urlErr.Err
+	if opErr, ok := cause.(*net.OpError); ok {
+		cause = opErr.Err
+	}
+	if syscallErr, ok := cause.(*os.SyscallError); ok {
+		cause = syscallErr.Err
+	}
+
+	switch cause {
+	case syscall.ECONNRESET, syscall.ECONNABORTED, syscall.EPIPE, io.EOF, io.ErrUnexpectedEOF:
+		return true
+	}
+	return false
+}
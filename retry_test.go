@@ -0,0 +1,282 @@
+package goshopify
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 2 * time.Millisecond
+	return policy
+}
+
+func TestRetryLogged(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logger := &testLogger{}
+	client.Retry = testRetryPolicy()
+	client.Logger = logger
+
+	calls := 0
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls < 2 {
+				return httpmock.NewStringResponse(502, `{"errors":"Bad Gateway"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+
+	if err := client.Get("foo", nil, nil); err != nil {
+		t.Fatalf("Client.Get returned error: %v", err)
+	}
+
+	record := logger.find("shopify: retrying request")
+	if record == nil {
+		t.Fatalf("Logger received %+v, expected a retry record", logger.records)
+	}
+	if record.level != "WARN" || record.attrs["path"] != "/foo" || record.attrs["attempt"] != 1 {
+		t.Errorf("Logger received %+v, expected a warning about the retry of /foo", record)
+	}
+}
+
+func TestRetryServerError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = testRetryPolicy()
+
+	var events []RetryEvent
+	client.Retry.OnRetry = func(e RetryEvent) {
+		events = append(events, e)
+	}
+
+	calls := 0
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			body, _ := ioutil.ReadAll(req.Body)
+			if string(body) != `{"foo":"bar"}` {
+				t.Errorf("attempt %d sent body %s, expected %s", calls, body, `{"foo":"bar"}`)
+			}
+			if calls < 3 {
+				return httpmock.NewStringResponse(503, `{"errors":"Unavailable"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"foo":"baz"}`), nil
+		})
+
+	resource := struct {
+		Foo string `json:"foo"`
+	}{}
+	err := client.Put("foo", map[string]string{"foo": "bar"}, &resource)
+	if err != nil {
+		t.Fatalf("Client.Put returned error: %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("Client.Put made %d attempts, expected 3", calls)
+	}
+	if resource.Foo != "baz" {
+		t.Errorf("Client.Put returned %q, expected %q", resource.Foo, "baz")
+	}
+	if len(events) != 2 {
+		t.Fatalf("OnRetry called %d times, expected 2", len(events))
+	}
+	for i, e := range events {
+		if e.Attempt != i+1 {
+			t.Errorf("RetryEvent.Attempt = %d, expected %d", e.Attempt, i+1)
+		}
+		if _, ok := e.Err.(ResponseError); !ok {
+			t.Errorf("RetryEvent.Err = %#v, expected ResponseError", e.Err)
+		}
+	}
+}
+
+func TestRetryPostNotRetried(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = testRetryPolicy()
+
+	calls := 0
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders.json",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			return httpmock.NewStringResponse(503, `{"errors":"Unavailable"}`), nil
+		})
+
+	err := client.Post("admin/orders.json", map[string]string{"foo": "bar"}, nil)
+	if e, ok := err.(ResponseError); !ok || e.Status != 503 {
+		t.Errorf("Client.Post returned error %#v, expected a 503 ResponseError", err)
+	}
+	if calls != 1 {
+		t.Errorf("Client.Post made %d attempts, expected 1", calls)
+	}
+}
+
+func TestRetryPostOptIn(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = testRetryPolicy()
+	client.Retry.RetryMethods = []string{"GET", "POST"}
+
+	calls := 0
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls < 2 {
+				return httpmock.NewStringResponse(503, `{"errors":"Unavailable"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+
+	if err := client.Post("foo", map[string]string{"foo": "bar"}, nil); err != nil {
+		t.Fatalf("Client.Post returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Client.Post made %d attempts, expected 2", calls)
+	}
+}
+
+func TestRetryPostRateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = testRetryPolicy()
+
+	calls := 0
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls < 2 {
+				return httpmock.NewStringResponse(429, `{"errors":"Exceeded 2 calls per second for api client."}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+
+	if err := client.Post("foo", map[string]string{"foo": "bar"}, nil); err != nil {
+		t.Fatalf("Client.Post returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Client.Post made %d attempts, expected 2", calls)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = testRetryPolicy()
+
+	calls := 0
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			return httpmock.NewStringResponse(500, `{"errors":"Internal"}`), nil
+		})
+
+	err := client.Get("foo", nil, nil)
+	expected := ResponseError{Status: 500, Message: "Internal"}
+	if e, ok := err.(ResponseError); !ok || e.Message != expected.Message {
+		t.Errorf("Client.Get returned error %#v, expected %#v", err, expected)
+	}
+	if calls != client.Retry.MaxAttempts {
+		t.Errorf("Client.Get made %d attempts, expected %d", calls, client.Retry.MaxAttempts)
+	}
+}
+
+func TestRetryNotRetriedStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = testRetryPolicy()
+
+	calls := 0
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/foo",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			return httpmock.NewStringResponse(404, `{"errors":"Not Found"}`), nil
+		})
+
+	err := client.Get("foo", nil, nil)
+	if err == nil {
+		t.Errorf("Client.Get returned nil error, expected ResponseError")
+	}
+	if calls != 1 {
+		t.Errorf("Client.Get made %d attempts, expected 1", calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 300 * time.Millisecond,
+	}
+
+	cases := []struct {
+		attempt int
+		err     error
+		min     time.Duration
+		max     time.Duration
+	}{
+		{1, ResponseError{Status: 500}, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, ResponseError{Status: 500}, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, ResponseError{Status: 500}, 150 * time.Millisecond, 300 * time.Millisecond},
+		{8, ResponseError{Status: 500}, 150 * time.Millisecond, 300 * time.Millisecond},
+		{1, RateLimitError{RetryAfter: 2}, 2 * time.Second, 2 * time.Second},
+		{1, RateLimitError{}, 50 * time.Millisecond, 100 * time.Millisecond},
+	}
+
+	for _, c := range cases {
+		wait := policy.backoff(c.attempt, c.err)
+		if wait < c.min || wait > c.max {
+			t.Errorf("backoff(%d, %#v) = %v, expected between %v and %v", c.attempt, c.err, wait, c.min, c.max)
+		}
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	cases := []struct {
+		method   string
+		err      error
+		expected bool
+	}{
+		{"GET", RateLimitError{ResponseError: ResponseError{Status: 429}}, true},
+		{"POST", RateLimitError{ResponseError: ResponseError{Status: 429}}, true},
+		{"GET", ResponseError{Status: 502}, true},
+		{"PUT", ResponseError{Status: 502}, true},
+		{"DELETE", ResponseError{Status: 503}, true},
+		{"POST", ResponseError{Status: 502}, false},
+		{"GET", ResponseDecodingError{Status: 500}, true},
+		{"GET", ResponseError{Status: 422}, false},
+		{"GET", &url.Error{Op: "Get", URL: "foo", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, true},
+		{"POST", &url.Error{Op: "Post", URL: "foo", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, false},
+		{"GET", &url.Error{Op: "Get", URL: "foo", Err: &net.OpError{Op: "write", Err: &os.SyscallError{Syscall: "write", Err: syscall.EPIPE}}}, true},
+		{"GET", &url.Error{Op: "Get", URL: "foo", Err: &net.OpError{Op: "dial", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}}, false},
+		{"GET", &url.Error{Op: "Get", URL: "foo", Err: io.EOF}, true},
+		{"GET", &url.Error{Op: "Get", URL: "foo", Err: errors.New("no such host")}, false},
+		{"GET", io.EOF, false},
+	}
+
+	for _, c := range cases {
+		if actual := policy.shouldRetry(c.method, c.err); actual != c.expected {
+			t.Errorf("shouldRetry(%s, %#v) = %v, expected %v", c.method, c.err, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package goshopify
+
+import "strings"
+
+// Scopes is a list of access scopes, e.g. "read_products" or "write_orders".
+type Scopes []string
+
+// ParseScopes parses a comma separated list of scopes like the Scope of an
+// App. Blank and duplicate scopes are dropped.
+func ParseScopes(scope string) Scopes {
+	var scopes Scopes
+	seen := make(map[string]bool)
+	for _, s := range strings.Split(scope, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		scopes = append(scopes, s)
+	}
+	return scopes
+}
+
+// String returns the scopes as a comma separated list.
+func (s Scopes) String() string {
+	return strings.Join(s, ",")
+}
+
+// Has reports whether scope is granted by s. A write scope implies the read
+// scope of the same resource, e.g. "write_orders" grants "read_orders".
+func (s Scopes) Has(scope string) bool {
+	implied := impliedByScope(scope)
+	for _, granted := range s {
+		if granted == scope || (implied != "" && granted == implied) {
+			return true
+		}
+	}
+	return false
+}
+
+// Includes reports whether all of required are granted by s.
+func (s Scopes) Includes(required Scopes) bool {
+	return len(s.Missing(required)) == 0
+}
+
+// Missing returns the scopes of required that are not granted by s.
+func (s Scopes) Missing(required Scopes) Scopes {
+	var missing Scopes
+	for _, scope := range required {
+		if !s.Has(scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+// impliedByScope returns the write scope implying the read scope, or an empty
+// string if scope is not a read scope.
+func impliedByScope(scope string) string {
+	for _, prefix := range []string{"read_", "unauthenticated_read_"} {
+		if strings.HasPrefix(scope, prefix) {
+			return strings.Replace(prefix, "read_", "write_", 1) + strings.TrimPrefix(scope, prefix)
+		}
+	}
+	return ""
+}
+
+// Scopes returns the parsed scopes of the app.
+func (app App) Scopes() Scopes {
+	return ParseScopes(app.Scope)
+}
+
+// ReauthorizeURL returns an authorization url requesting the app's scopes if
+// the granted scopes do not include all of them, e.g. after scopes were added
+// to the app. It returns false if the granted scopes are sufficient.
+func (app App) ReauthorizeURL(shopName string, state string, granted Scopes) (string, bool) {
+	if granted.Includes(app.Scopes()) {
+		return "", false
+	}
+	return app.AuthorizeURL(shopName, state), true
+}
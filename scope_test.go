@@ -0,0 +1,88 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseScopes(t *testing.T) {
+	cases := []struct {
+		scope    string
+		expected Scopes
+	}{
+		{"", nil},
+		{"read_products", Scopes{"read_products"}},
+		{"read_products, write_orders,,read_products", Scopes{"read_products", "write_orders"}},
+	}
+
+	for _, c := range cases {
+		actual := ParseScopes(c.scope)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("ParseScopes(%q) returned %v, expected %v", c.scope, actual, c.expected)
+		}
+	}
+
+	if s := ParseScopes(" read_products ,write_orders").String(); s != "read_products,write_orders" {
+		t.Errorf("Scopes.String() returned %q, expected %q", s, "read_products,write_orders")
+	}
+}
+
+func TestScopesHas(t *testing.T) {
+	granted := Scopes{"read_products", "write_orders", "unauthenticated_write_checkouts"}
+
+	cases := []struct {
+		scope    string
+		expected bool
+	}{
+		{"read_products", true},
+		{"write_products", false},
+		{"write_orders", true},
+		{"read_orders", true},
+		{"read_customers", false},
+		{"unauthenticated_read_checkouts", true},
+		{"unauthenticated_write_checkouts", true},
+		{"unauthenticated_read_products", false},
+	}
+
+	for _, c := range cases {
+		if actual := granted.Has(c.scope); actual != c.expected {
+			t.Errorf("Scopes.Has(%q) returned %v, expected %v", c.scope, actual, c.expected)
+		}
+	}
+}
+
+func TestScopesMissing(t *testing.T) {
+	granted := Scopes{"read_products", "write_orders"}
+
+	missing := granted.Missing(Scopes{"read_orders", "write_products", "read_customers"})
+	expected := Scopes{"write_products", "read_customers"}
+	if !reflect.DeepEqual(missing, expected) {
+		t.Errorf("Scopes.Missing() returned %v, expected %v", missing, expected)
+	}
+
+	if !granted.Includes(Scopes{"read_products", "read_orders"}) {
+		t.Errorf("Scopes.Includes() returned false, expected true")
+	}
+	if granted.Includes(Scopes{"read_products", "read_customers"}) {
+		t.Errorf("Scopes.Includes() returned true, expected false")
+	}
+}
+
+func TestAppReauthorizeURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	scopedApp := app
+	scopedApp.Scope = "read_products,write_orders"
+
+	authURL, ok := scopedApp.ReauthorizeURL("fooshop", "thenonce", Scopes{"write_products", "write_orders"})
+	if ok || authURL != "" {
+		t.Errorf("App.ReauthorizeURL() with sufficient scopes returned %q, %v, expected no url", authURL, ok)
+	}
+
+	authURL, ok = scopedApp.ReauthorizeURL("fooshop", "thenonce", Scopes{"read_products"})
+	expected := scopedApp.AuthorizeURL("fooshop", "thenonce")
+	if !ok || authURL != expected {
+		t.Errorf("App.ReauthorizeURL() with missing scopes returned %q, %v, expected %q", authURL, ok, expected)
+	}
+}
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -12,11 +13,21 @@ const scriptTagsBasePath = "admin/script_tags"
 // See: https://help.shopify.com/api/reference/scripttag
 type ScriptTagAPI interface {
 	List(interface{}) ([]ScriptTag, error)
+	ListWithContext(context.Context, interface{}) ([]ScriptTag, error)
+	ListWithPagination(interface{}) ([]ScriptTag, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]ScriptTag, *Pagination, error)
+	ListAll(interface{}) ([]ScriptTag, error)
+	ListAllWithContext(context.Context, interface{}) ([]ScriptTag, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*ScriptTag, error)
+	GetWithContext(context.Context, int, interface{}) (*ScriptTag, error)
 	Create(ScriptTag) (*ScriptTag, error)
+	CreateWithContext(context.Context, ScriptTag) (*ScriptTag, error)
 	Update(ScriptTag) (*ScriptTag, error)
+	UpdateWithContext(context.Context, ScriptTag) (*ScriptTag, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 }
 
 // ScriptTagAPIOp handles communication with the shop related methods of the
@@ -62,45 +73,99 @@ type ScriptTagResource struct {
 
 // List script tags
 func (s *ScriptTagAPIOp) List(options interface{}) ([]ScriptTag, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ScriptTagAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]ScriptTag, error) {
 	path := fmt.Sprintf("%s.json", scriptTagsBasePath)
 	resource := &ScriptTagsResource{}
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.ScriptTags, err
 }
 
+// ListWithPagination lists script tags and returns the pagination to request the
+// next and previous pages.
+func (s *ScriptTagAPIOp) ListWithPagination(options interface{}) ([]ScriptTag, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *ScriptTagAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]ScriptTag, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", scriptTagsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *ScriptTagsResource) []ScriptTag { return r.ScriptTags })
+}
+
+// ListAll lists all script tags, following the pagination from the page
+// requested by options to the last page.
+func (s *ScriptTagAPIOp) ListAll(options interface{}) ([]ScriptTag, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *ScriptTagAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]ScriptTag, error) {
+	path := fmt.Sprintf("%s.json", scriptTagsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *ScriptTagsResource) []ScriptTag { return r.ScriptTags })
+}
+
 // Count script tags
 func (s *ScriptTagAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *ScriptTagAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", scriptTagsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual script tag
 func (s *ScriptTagAPIOp) Get(tagID int, options interface{}) (*ScriptTag, error) {
+	return s.GetWithContext(context.Background(), tagID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ScriptTagAPIOp) GetWithContext(ctx context.Context, tagID int, options interface{}) (*ScriptTag, error) {
 	path := fmt.Sprintf("%s/%d.json", scriptTagsBasePath, tagID)
 	resource := &ScriptTagResource{}
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.ScriptTag, err
 }
 
 // Create a new script tag
 func (s *ScriptTagAPIOp) Create(tag ScriptTag) (*ScriptTag, error) {
+	return s.CreateWithContext(context.Background(), tag)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ScriptTagAPIOp) CreateWithContext(ctx context.Context, tag ScriptTag) (*ScriptTag, error) {
 	path := fmt.Sprintf("%s.json", scriptTagsBasePath)
 	wrappedData := ScriptTagResource{ScriptTag: &tag}
 	resource := &ScriptTagResource{}
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.ScriptTag, err
 }
 
 // Update an existing script tag
 func (s *ScriptTagAPIOp) Update(tag ScriptTag) (*ScriptTag, error) {
+	return s.UpdateWithContext(context.Background(), tag)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ScriptTagAPIOp) UpdateWithContext(ctx context.Context, tag ScriptTag) (*ScriptTag, error) {
 	path := fmt.Sprintf("%s/%d.json", scriptTagsBasePath, tag.ID)
 	wrappedData := ScriptTagResource{ScriptTag: &tag}
 	resource := &ScriptTagResource{}
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.ScriptTag, err
 }
 
 // Delete an existing script tag
 func (s *ScriptTagAPIOp) Delete(tagID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", scriptTagsBasePath, tagID))
+	return s.DeleteWithContext(context.Background(), tagID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ScriptTagAPIOp) DeleteWithContext(ctx context.Context, tagID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", scriptTagsBasePath, tagID))
 }
@@ -0,0 +1,162 @@
+package goshopify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// sessionTokenLeeway is the clock skew tolerated when checking the expiry
+// and not before times of a session token.
+const sessionTokenLeeway = 10 * time.Second
+
+var (
+	// ErrSessionTokenMalformed is returned for a session token that is not
+	// an HS256 JWT.
+	ErrSessionTokenMalformed = errors.New("malformed session token")
+
+	// ErrSessionTokenSignature is returned for a session token that is not
+	// signed with the app's secret.
+	ErrSessionTokenSignature = errors.New("invalid session token signature")
+
+	// ErrSessionTokenExpired is returned for a session token that has
+	// expired or is not valid yet.
+	ErrSessionTokenExpired = errors.New("session token expired or not yet valid")
+
+	// ErrSessionTokenAudience is returned for a session token issued to
+	// another app.
+	ErrSessionTokenAudience = errors.New("session token issued to another app")
+
+	// ErrSessionTokenShop is returned for a session token whose issuer and
+	// destination are not the same shop.
+	ErrSessionTokenShop = errors.New("session token issuer and destination do not match")
+)
+
+// SessionClaims are the claims of a session token, which App Bridge issues
+// to authenticate the requests of an embedded app's frontend.
+type SessionClaims struct {
+	Issuer      string `json:"iss"`
+	Destination string `json:"dest"`
+	Audience    string `json:"aud"`
+	Subject     string `json:"sub"`
+	ExpiresAt   int64  `json:"exp"`
+	NotBefore   int64  `json:"nbf"`
+	IssuedAt    int64  `json:"iat"`
+	ID          string `json:"jti"`
+	SessionID   string `json:"sid"`
+}
+
+// Shop returns the myshopify.com domain of the shop the token was issued
+// for, e.g. "fooshop.myshopify.com".
+func (c SessionClaims) Shop() string {
+	dest, err := url.Parse(c.Destination)
+	if err != nil {
+		return ""
+	}
+	return dest.Host
+}
+
+type sessionClaimsContextKey struct{}
+
+// VerifySessionToken verifies a session token signed with the app's secret
+// and returns its claims. The token must be valid now, give or take a few
+// seconds of clock skew, must be issued to the app, and its issuer and
+// destination must be the same shop.
+func (app App) VerifySessionToken(token string) (*SessionClaims, error) {
+	return app.verifySessionToken(token, time.Now())
+}
+
+func (app App) verifySessionToken(token string, now time.Time) (*SessionClaims, error) {
+	if app.APISecret == "" {
+		return nil, errors.New("APISecret is empty")
+	}
+	if app.APIKey == "" {
+		return nil, errors.New("APIKey is empty")
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrSessionTokenMalformed
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+	if err := decodeSessionTokenPart(parts[0], &header); err != nil || header.Algorithm != "HS256" {
+		return nil, ErrSessionTokenMalformed
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrSessionTokenMalformed
+	}
+	mac := hmac.New(sha256.New, []byte(app.APISecret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrSessionTokenSignature
+	}
+
+	claims := new(SessionClaims)
+	if err := decodeSessionTokenPart(parts[1], claims); err != nil {
+		return nil, ErrSessionTokenMalformed
+	}
+
+	if now.Add(-sessionTokenLeeway).Unix() > claims.ExpiresAt ||
+		now.Add(sessionTokenLeeway).Unix() < claims.NotBefore {
+		return nil, ErrSessionTokenExpired
+	}
+
+	if claims.Audience != app.APIKey {
+		return nil, ErrSessionTokenAudience
+	}
+
+	issuer, err := url.Parse(claims.Issuer)
+	if err != nil {
+		return nil, ErrSessionTokenShop
+	}
+	dest, err := url.Parse(claims.Destination)
+	if err != nil || !validShopDomain(dest.Host) || issuer.Host != dest.Host {
+		return nil, ErrSessionTokenShop
+	}
+
+	return claims, nil
+}
+
+func decodeSessionTokenPart(part string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// SessionTokenMiddleware authenticates requests with the session token in
+// their Authorization header and puts its claims into the request context,
+// see SessionClaimsFromContext. Requests without a valid token are rejected
+// with 401 Unauthorized, asking App Bridge to retry them with a fresh token.
+func (app App) SessionTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		claims, err := app.VerifySessionToken(token)
+		if err != nil {
+			w.Header().Set("X-Shopify-Retry-Invalid-Session-Request", "1")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), sessionClaimsContextKey{}, claims)))
+	})
+}
+
+// SessionClaimsFromContext returns the session claims put into ctx by
+// SessionTokenMiddleware.
+func SessionClaimsFromContext(ctx context.Context) (*SessionClaims, bool) {
+	claims, ok := ctx.Value(sessionClaimsContextKey{}).(*SessionClaims)
+	return claims, ok
+}
@@ -0,0 +1,187 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var sessionTokenTime = time.Unix(1600000000, 0)
+
+// newSessionToken returns a session token with claims signed with secret.
+func newSessionToken(secret, alg string, claims SessionClaims) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func validSessionClaims() SessionClaims {
+	return SessionClaims{
+		Issuer:      "https://fooshop.myshopify.com/admin",
+		Destination: "https://fooshop.myshopify.com",
+		Audience:    "apikey",
+		Subject:     "42",
+		ExpiresAt:   sessionTokenTime.Add(time.Minute).Unix(),
+		NotBefore:   sessionTokenTime.Unix(),
+		IssuedAt:    sessionTokenTime.Unix(),
+		ID:          "f8912129-1af6-4cad-9ca3-76b0f7621087",
+		SessionID:   "aaea182f2732d44c23057c0fea584021a4485b2bd25d3eb7fd349313ad24c685",
+	}
+}
+
+func TestAppVerifySessionToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	expected := validSessionClaims()
+	claims, err := app.verifySessionToken(newSessionToken("hush", "HS256", expected), sessionTokenTime)
+	if err != nil {
+		t.Fatalf("App.VerifySessionToken returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(*claims, expected) {
+		t.Errorf("App.VerifySessionToken returned %+v, expected %+v", *claims, expected)
+	}
+
+	if shop := claims.Shop(); shop != "fooshop.myshopify.com" {
+		t.Errorf("SessionClaims.Shop returned %s, expected fooshop.myshopify.com", shop)
+	}
+}
+
+func TestAppVerifySessionTokenInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	claims := func(f func(c *SessionClaims)) SessionClaims {
+		c := validSessionClaims()
+		f(&c)
+		return c
+	}
+
+	valid := newSessionToken("hush", "HS256", validSessionClaims())
+
+	cases := []struct {
+		name     string
+		token    string
+		now      time.Time
+		expected error
+	}{
+		{"empty", "", sessionTokenTime, ErrSessionTokenMalformed},
+		{"garbage", "a.b.c", sessionTokenTime, ErrSessionTokenMalformed},
+		{"alg none", newSessionToken("hush", "none", validSessionClaims()), sessionTokenTime, ErrSessionTokenMalformed},
+		{"other secret", newSessionToken("other", "HS256", validSessionClaims()), sessionTokenTime, ErrSessionTokenSignature},
+		{"tampered", valid[:len(valid)-4] + "AAAA", sessionTokenTime, ErrSessionTokenSignature},
+		{"expired", valid, sessionTokenTime.Add(2 * time.Minute), ErrSessionTokenExpired},
+		{"not yet valid", valid, sessionTokenTime.Add(-time.Minute), ErrSessionTokenExpired},
+		{"other app", newSessionToken("hush", "HS256", claims(func(c *SessionClaims) { c.Audience = "otherkey" })), sessionTokenTime, ErrSessionTokenAudience},
+		{"other issuer", newSessionToken("hush", "HS256", claims(func(c *SessionClaims) { c.Issuer = "https://barshop.myshopify.com/admin" })), sessionTokenTime, ErrSessionTokenShop},
+		{"invalid destination", newSessionToken("hush", "HS256", claims(func(c *SessionClaims) {
+			c.Issuer = "https://evil.com/admin"
+			c.Destination = "https://evil.com"
+		})), sessionTokenTime, ErrSessionTokenShop},
+	}
+
+	for _, c := range cases {
+		_, err := app.verifySessionToken(c.token, c.now)
+		if err != c.expected {
+			t.Errorf("App.VerifySessionToken with %s token returned %v, expected %v", c.name, err, c.expected)
+		}
+	}
+}
+
+func TestAppVerifySessionTokenWithoutCredentials(t *testing.T) {
+	cases := []struct {
+		name string
+		app  App
+	}{
+		{"secret", App{APIKey: "apikey"}},
+		{"key", App{APISecret: "hush"}},
+	}
+
+	for _, c := range cases {
+		claims := validSessionClaims()
+		claims.Audience = c.app.APIKey
+		token := newSessionToken(c.app.APISecret, "HS256", claims)
+		if _, err := c.app.verifySessionToken(token, sessionTokenTime); err == nil {
+			t.Errorf("App.VerifySessionToken without %s returned nil error", c.name)
+		}
+	}
+}
+
+func TestAppVerifySessionTokenClockSkew(t *testing.T) {
+	setup()
+	defer teardown()
+
+	token := newSessionToken("hush", "HS256", validSessionClaims())
+
+	for _, now := range []time.Time{sessionTokenTime.Add(-5 * time.Second), sessionTokenTime.Add(time.Minute + 5*time.Second)} {
+		if _, err := app.verifySessionToken(token, now); err != nil {
+			t.Errorf("App.VerifySessionToken at %v returned error: %v", now, err)
+		}
+	}
+}
+
+func TestAppSessionTokenMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	claims := validSessionClaims()
+	claims.ExpiresAt = time.Now().Add(time.Minute).Unix()
+	claims.NotBefore = time.Now().Unix()
+
+	var received *SessionClaims
+	handler := app.SessionTokenMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = SessionClaimsFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest("GET", "https://app.example.com/api/products", nil)
+	req.Header.Set("Authorization", "Bearer "+newSessionToken("hush", "HS256", claims))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("SessionTokenMiddleware returned status %d, expected %d", w.Code, http.StatusOK)
+	}
+	if received == nil || !reflect.DeepEqual(*received, claims) {
+		t.Errorf("SessionTokenMiddleware put %+v into the context, expected %+v", received, claims)
+	}
+}
+
+func TestAppSessionTokenMiddlewareUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+
+	called := false
+	handler := app.SessionTokenMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest("GET", "https://app.example.com/api/products", nil)
+	req.Header.Set("Authorization", "Bearer "+newSessionToken("other", "HS256", validSessionClaims()))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("SessionTokenMiddleware returned status %d, expected %d", w.Code, http.StatusUnauthorized)
+	}
+	if w.Header().Get("X-Shopify-Retry-Invalid-Session-Request") != "1" {
+		t.Errorf("SessionTokenMiddleware did not ask App Bridge to retry")
+	}
+	if called {
+		t.Errorf("SessionTokenMiddleware called the handler for an invalid token")
+	}
+
+	if _, ok := SessionClaimsFromContext(req.Context()); ok {
+		t.Errorf("SessionClaimsFromContext returned claims for a context without them")
+	}
+}
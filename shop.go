@@ -1,12 +1,16 @@
 package goshopify
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // ShopAPI is an interface for interfacing with the shop endpoint of the
 // Shopify API.
 // See: https://help.shopify.com/api/reference/shop
 type ShopAPI interface {
 	Get(options interface{}) (*Shop, error)
+	GetWithContext(ctx context.Context, options interface{}) (*Shop, error)
 }
 
 // ShopAPIOp handles communication with the shop related methods of the
@@ -67,7 +71,12 @@ type ShopResource struct {
 
 // Get shop
 func (s *ShopAPIOp) Get(options interface{}) (*Shop, error) {
+	return s.GetWithContext(context.Background(), options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ShopAPIOp) GetWithContext(ctx context.Context, options interface{}) (*Shop, error) {
 	resource := new(ShopResource)
-	err := s.client.Get("admin/shop.json", resource, options)
+	err := s.client.GetWithContext(ctx, "admin/shop.json", resource, options)
 	return resource.Shop, err
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -13,11 +14,21 @@ const smartCollectionsResourceName = "collections"
 // See https://help.shopify.com/api/reference/smartcollection
 type SmartCollectionAPI interface {
 	List(interface{}) ([]SmartCollection, error)
+	ListWithContext(context.Context, interface{}) ([]SmartCollection, error)
+	ListWithPagination(interface{}) ([]SmartCollection, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]SmartCollection, *Pagination, error)
+	ListAll(interface{}) ([]SmartCollection, error)
+	ListAllWithContext(context.Context, interface{}) ([]SmartCollection, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*SmartCollection, error)
+	GetWithContext(context.Context, int, interface{}) (*SmartCollection, error)
 	Create(SmartCollection) (*SmartCollection, error)
+	CreateWithContext(context.Context, SmartCollection) (*SmartCollection, error)
 	Update(SmartCollection) (*SmartCollection, error)
+	UpdateWithContext(context.Context, SmartCollection) (*SmartCollection, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 
 	// MetafieldsAPI used for SmartCollection resource to communicate with Metafields resource
 	MetafieldsAPI
@@ -66,82 +77,166 @@ type SmartCollectionsResource struct {
 
 // List smart collections
 func (s *SmartCollectionAPIOp) List(options interface{}) ([]SmartCollection, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *SmartCollectionAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]SmartCollection, error) {
 	path := fmt.Sprintf("%s.json", smartCollectionsBasePath)
 	resource := new(SmartCollectionsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Collections, err
 }
 
+// ListWithPagination lists smart collections and returns the pagination to request the
+// next and previous pages.
+func (s *SmartCollectionAPIOp) ListWithPagination(options interface{}) ([]SmartCollection, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *SmartCollectionAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]SmartCollection, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", smartCollectionsBasePath)
+	return listPage(ctx, s.client, path, options, func(r *SmartCollectionsResource) []SmartCollection { return r.Collections })
+}
+
+// ListAll lists all smart collections, following the pagination from the page
+// requested by options to the last page.
+func (s *SmartCollectionAPIOp) ListAll(options interface{}) ([]SmartCollection, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *SmartCollectionAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]SmartCollection, error) {
+	path := fmt.Sprintf("%s.json", smartCollectionsBasePath)
+	return listAll(ctx, s.client, path, options, func(r *SmartCollectionsResource) []SmartCollection { return r.Collections })
+}
+
 // Count smart collections
 func (s *SmartCollectionAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *SmartCollectionAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", smartCollectionsBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual smart collection
 func (s *SmartCollectionAPIOp) Get(collectionID int, options interface{}) (*SmartCollection, error) {
+	return s.GetWithContext(context.Background(), collectionID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *SmartCollectionAPIOp) GetWithContext(ctx context.Context, collectionID int, options interface{}) (*SmartCollection, error) {
 	path := fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collectionID)
 	resource := new(SmartCollectionResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Collection, err
 }
 
 // Create a new smart collection
 // See Image for the details of the Image creation for a collection.
 func (s *SmartCollectionAPIOp) Create(collection SmartCollection) (*SmartCollection, error) {
+	return s.CreateWithContext(context.Background(), collection)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *SmartCollectionAPIOp) CreateWithContext(ctx context.Context, collection SmartCollection) (*SmartCollection, error) {
 	path := fmt.Sprintf("%s.json", smartCollectionsBasePath)
 	wrappedData := SmartCollectionResource{Collection: &collection}
 	resource := new(SmartCollectionResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Collection, err
 }
 
 // Update an existing smart collection
 func (s *SmartCollectionAPIOp) Update(collection SmartCollection) (*SmartCollection, error) {
+	return s.UpdateWithContext(context.Background(), collection)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *SmartCollectionAPIOp) UpdateWithContext(ctx context.Context, collection SmartCollection) (*SmartCollection, error) {
 	path := fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collection.ID)
 	wrappedData := SmartCollectionResource{Collection: &collection}
 	resource := new(SmartCollectionResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Collection, err
 }
 
 // Delete an existing smart collection.
 func (s *SmartCollectionAPIOp) Delete(collectionID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collectionID))
+	return s.DeleteWithContext(context.Background(), collectionID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *SmartCollectionAPIOp) DeleteWithContext(ctx context.Context, collectionID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collectionID))
 }
 
 // ListMetafields list metafields for a smart collection
 func (s *SmartCollectionAPIOp) ListMetafields(smartCollectionID int, options interface{}) ([]Metafield, error) {
+	return s.ListMetafieldsWithContext(context.Background(), smartCollectionID, options)
+}
+
+// ListMetafieldsWithContext is the context-aware variant of ListMetafields.
+func (s *SmartCollectionAPIOp) ListMetafieldsWithContext(ctx context.Context, smartCollectionID int, options interface{}) ([]Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
-	return metafieldAPI.List(options)
+	return metafieldAPI.ListWithContext(ctx, options)
 }
 
 // CountMetafields count metafields for a smart collection
 func (s *SmartCollectionAPIOp) CountMetafields(smartCollectionID int, options interface{}) (int, error) {
+	return s.CountMetafieldsWithContext(context.Background(), smartCollectionID, options)
+}
+
+// CountMetafieldsWithContext is the context-aware variant of CountMetafields.
+func (s *SmartCollectionAPIOp) CountMetafieldsWithContext(ctx context.Context, smartCollectionID int, options interface{}) (int, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
-	return metafieldAPI.Count(options)
+	return metafieldAPI.CountWithContext(ctx, options)
 }
 
 // GetMetafield get individual metafield for a smart collection
 func (s *SmartCollectionAPIOp) GetMetafield(smartCollectionID int, metafieldID int, options interface{}) (*Metafield, error) {
+	return s.GetMetafieldWithContext(context.Background(), smartCollectionID, metafieldID, options)
+}
+
+// GetMetafieldWithContext is the context-aware variant of GetMetafield.
+func (s *SmartCollectionAPIOp) GetMetafieldWithContext(ctx context.Context, smartCollectionID int, metafieldID int, options interface{}) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
-	return metafieldAPI.Get(metafieldID, options)
+	return metafieldAPI.GetWithContext(ctx, metafieldID, options)
 }
 
 // CreateMetafield create a new metafield for a smart collection
 func (s *SmartCollectionAPIOp) CreateMetafield(smartCollectionID int, metafield Metafield) (*Metafield, error) {
+	return s.CreateMetafieldWithContext(context.Background(), smartCollectionID, metafield)
+}
+
+// CreateMetafieldWithContext is the context-aware variant of CreateMetafield.
+func (s *SmartCollectionAPIOp) CreateMetafieldWithContext(ctx context.Context, smartCollectionID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
-	return metafieldAPI.Create(metafield)
+	return metafieldAPI.CreateWithContext(ctx, metafield)
 }
 
 // UpdateMetafield update an existing metafield for a smart collection
 func (s *SmartCollectionAPIOp) UpdateMetafield(smartCollectionID int, metafield Metafield) (*Metafield, error) {
+	return s.UpdateMetafieldWithContext(context.Background(), smartCollectionID, metafield)
+}
+
+// UpdateMetafieldWithContext is the context-aware variant of UpdateMetafield.
+func (s *SmartCollectionAPIOp) UpdateMetafieldWithContext(ctx context.Context, smartCollectionID int, metafield Metafield) (*Metafield, error) {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
-	return metafieldAPI.Update(metafield)
+	return metafieldAPI.UpdateWithContext(ctx, metafield)
 }
 
 // DeleteMetafield delete an existing metafield for a smart collection
 func (s *SmartCollectionAPIOp) DeleteMetafield(smartCollectionID int, metafieldID int) error {
+	return s.DeleteMetafieldWithContext(context.Background(), smartCollectionID, metafieldID)
+}
+
+// DeleteMetafieldWithContext is the context-aware variant of DeleteMetafield.
+func (s *SmartCollectionAPIOp) DeleteMetafieldWithContext(ctx context.Context, smartCollectionID int, metafieldID int) error {
 	metafieldAPI := &MetafieldAPIOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
-	return metafieldAPI.Delete(metafieldID)
+	return metafieldAPI.DeleteWithContext(ctx, metafieldID)
 }
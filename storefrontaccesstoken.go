@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -12,8 +13,11 @@ const storefrontAccessTokensBasePath = "admin/storefront_access_tokens"
 // See: https://help.shopify.com/api/reference/access/storefrontaccesstoken
 type StorefrontAccessTokenAPI interface {
 	List(interface{}) ([]StorefrontAccessToken, error)
+	ListWithContext(context.Context, interface{}) ([]StorefrontAccessToken, error)
 	Create(StorefrontAccessToken) (*StorefrontAccessToken, error)
+	CreateWithContext(context.Context, StorefrontAccessToken) (*StorefrontAccessToken, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
 }
 
 // StorefrontAccessTokenAPIOp handles communication with the storefront access token
@@ -44,22 +48,37 @@ type StorefrontAccessTokensResource struct {
 
 // List storefront access tokens
 func (s *StorefrontAccessTokenAPIOp) List(options interface{}) ([]StorefrontAccessToken, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *StorefrontAccessTokenAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]StorefrontAccessToken, error) {
 	path := fmt.Sprintf("%s.json", storefrontAccessTokensBasePath)
 	resource := new(StorefrontAccessTokensResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.StorefrontAccessTokens, err
 }
 
 // Create a new storefront access token
 func (s *StorefrontAccessTokenAPIOp) Create(storefrontAccessToken StorefrontAccessToken) (*StorefrontAccessToken, error) {
+	return s.CreateWithContext(context.Background(), storefrontAccessToken)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *StorefrontAccessTokenAPIOp) CreateWithContext(ctx context.Context, storefrontAccessToken StorefrontAccessToken) (*StorefrontAccessToken, error) {
 	path := fmt.Sprintf("%s.json", storefrontAccessTokensBasePath)
 	wrappedData := StorefrontAccessTokenResource{StorefrontAccessToken: &storefrontAccessToken}
 	resource := new(StorefrontAccessTokenResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.StorefrontAccessToken, err
 }
 
 // Delete an existing storefront access token
 func (s *StorefrontAccessTokenAPIOp) Delete(ID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", storefrontAccessTokensBasePath, ID))
+	return s.DeleteWithContext(context.Background(), ID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *StorefrontAccessTokenAPIOp) DeleteWithContext(ctx context.Context, ID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", storefrontAccessTokensBasePath, ID))
 }
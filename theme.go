@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -12,6 +13,7 @@ const themesBasePath = "admin/themes"
 // See: https://help.shopify.com/api/reference/theme
 type ThemeAPI interface {
 	List(interface{}) ([]Theme, error)
+	ListWithContext(context.Context, interface{}) ([]Theme, error)
 }
 
 // ThemeAPIOp handles communication with the theme related methods of
@@ -45,8 +47,13 @@ type ThemesResource struct {
 
 // List all themes
 func (s *ThemeAPIOp) List(options interface{}) ([]Theme, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ThemeAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Theme, error) {
 	path := fmt.Sprintf("%s.json", themesBasePath)
 	resource := new(ThemesResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Themes, err
 }
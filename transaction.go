@@ -1,15 +1,22 @@
 package goshopify
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // TransactionAPI is an interface for interfacing with the transactions endpoints of
 // the Shopify API.
 // See: https://help.shopify.com/api/reference/transaction
 type TransactionAPI interface {
 	List(int, interface{}) ([]Transaction, error)
+	ListWithContext(context.Context, int, interface{}) ([]Transaction, error)
 	Count(int, interface{}) (int, error)
+	CountWithContext(context.Context, int, interface{}) (int, error)
 	Get(int, int, interface{}) (*Transaction, error)
+	GetWithContext(context.Context, int, int, interface{}) (*Transaction, error)
 	Create(int, Transaction) (*Transaction, error)
+	CreateWithContext(context.Context, int, Transaction) (*Transaction, error)
 }
 
 // TransactionAPIOp handles communication with the transaction related methods of the
@@ -30,31 +37,51 @@ type TransactionsResource struct {
 
 // List transactions
 func (s *TransactionAPIOp) List(orderID int, options interface{}) ([]Transaction, error) {
+	return s.ListWithContext(context.Background(), orderID, options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *TransactionAPIOp) ListWithContext(ctx context.Context, orderID int, options interface{}) ([]Transaction, error) {
 	path := fmt.Sprintf("%s/%d/transactions.json", ordersBasePath, orderID)
 	resource := new(TransactionsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Transactions, err
 }
 
 // Count transactions
 func (s *TransactionAPIOp) Count(orderID int, options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), orderID, options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *TransactionAPIOp) CountWithContext(ctx context.Context, orderID int, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/%d/transactions/count.json", ordersBasePath, orderID)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual transaction
 func (s *TransactionAPIOp) Get(orderID int, transactionID int, options interface{}) (*Transaction, error) {
+	return s.GetWithContext(context.Background(), orderID, transactionID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *TransactionAPIOp) GetWithContext(ctx context.Context, orderID int, transactionID int, options interface{}) (*Transaction, error) {
 	path := fmt.Sprintf("%s/%d/transactions/%d.json", ordersBasePath, orderID, transactionID)
 	resource := new(TransactionResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Transaction, err
 }
 
 // Create a new transaction
 func (s *TransactionAPIOp) Create(orderID int, transaction Transaction) (*Transaction, error) {
+	return s.CreateWithContext(context.Background(), orderID, transaction)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *TransactionAPIOp) CreateWithContext(ctx context.Context, orderID int, transaction Transaction) (*Transaction, error) {
 	path := fmt.Sprintf("%s/%d/transactions.json", ordersBasePath, orderID)
 	wrappedData := TransactionResource{Transaction: &transaction}
 	resource := new(TransactionResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Transaction, err
 }
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -15,8 +16,11 @@ const usageChargesPath = "usage_charges"
 // See https://help.shopify.com/en/api/reference/billing/usagecharge#endpoints
 type UsageChargeAPI interface {
 	Create(int, UsageCharge) (*UsageCharge, error)
+	CreateWithContext(context.Context, int, UsageCharge) (*UsageCharge, error)
 	Get(int, int, interface{}) (*UsageCharge, error)
+	GetWithContext(context.Context, int, int, interface{}) (*UsageCharge, error)
 	List(int, interface{}) ([]UsageCharge, error)
+	ListWithContext(context.Context, int, interface{}) ([]UsageCharge, error)
 }
 
 // UsageChargeAPIOp handles communication with the
@@ -74,10 +78,17 @@ type UsageChargesResource struct {
 func (r *UsageChargeAPIOp) Create(chargeID int, usageCharge UsageCharge) (
 	*UsageCharge, error) {
 
+	return r.CreateWithContext(context.Background(), chargeID, usageCharge)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (r *UsageChargeAPIOp) CreateWithContext(ctx context.Context, chargeID int, usageCharge UsageCharge) (
+	*UsageCharge, error) {
+
 	path := fmt.Sprintf("%s/%d/%s.json", recurringApplicationChargesBasePath, chargeID, usageChargesPath)
 	wrappedData := UsageChargeResource{Charge: &usageCharge}
 	resource := &UsageChargeResource{}
-	err := r.client.Post(path, wrappedData, resource)
+	err := r.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Charge, err
 }
 
@@ -85,9 +96,16 @@ func (r *UsageChargeAPIOp) Create(chargeID int, usageCharge UsageCharge) (
 func (r *UsageChargeAPIOp) Get(chargeID int, usageChargeID int, options interface{}) (
 	*UsageCharge, error) {
 
+	return r.GetWithContext(context.Background(), chargeID, usageChargeID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (r *UsageChargeAPIOp) GetWithContext(ctx context.Context, chargeID int, usageChargeID int, options interface{}) (
+	*UsageCharge, error) {
+
 	path := fmt.Sprintf("%s/%d/%s/%d.json", recurringApplicationChargesBasePath, chargeID, usageChargesPath, usageChargeID)
 	resource := &UsageChargeResource{}
-	err := r.client.Get(path, resource, options)
+	err := r.client.GetWithContext(ctx, path, resource, options)
 	return resource.Charge, err
 }
 
@@ -95,8 +113,15 @@ func (r *UsageChargeAPIOp) Get(chargeID int, usageChargeID int, options interfac
 func (r *UsageChargeAPIOp) List(chargeID int, options interface{}) (
 	[]UsageCharge, error) {
 
+	return r.ListWithContext(context.Background(), chargeID, options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (r *UsageChargeAPIOp) ListWithContext(ctx context.Context, chargeID int, options interface{}) (
+	[]UsageCharge, error) {
+
 	path := fmt.Sprintf("%s/%d/%s.json", recurringApplicationChargesBasePath, chargeID, usageChargesPath)
 	resource := &UsageChargesResource{}
-	err := r.client.Get(path, resource, options)
+	err := r.client.GetWithContext(ctx, path, resource, options)
 	return resource.Charges, err
 }
@@ -1,10 +1,16 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 )
 
+// shopDomainRegex matches the myshopify.com domain of a shop.
+var shopDomainRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\-]*\.myshopify\.com$`)
+
 // ShopFullName return the full shop name, including .myshopify.com
 func ShopFullName(name string) string {
 	name = strings.TrimSpace(name)
@@ -28,6 +34,13 @@ func ShopBaseURL(name string) string {
 	return "https://" + ShopFullName(name)
 }
 
+// validShopDomain reports whether shop is the myshopify.com domain of a shop,
+// e.g. "fooshop.myshopify.com". Domains sent by clients must be validated
+// before requests are made to them.
+func validShopDomain(shop string) bool {
+	return shopDomainRegex.MatchString(shop)
+}
+
 // MetafieldPathPrefix return the prefix for a metafield path
 func MetafieldPathPrefix(resource string, resourceID int) string {
 	var prefix string
@@ -102,3 +115,20 @@ func IntValue(v *int) int {
 	}
 	return 0
 }
+
+// sleepContext waits for the given duration or until ctx is done, whichever
+// happens first. It returns the context's error if ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
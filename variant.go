@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,11 +15,21 @@ const variantsBasePath = "admin/variants"
 // See https://help.shopify.com/api/reference/product_variant
 type VariantAPI interface {
 	List(int, interface{}) ([]Variant, error)
+	ListWithContext(context.Context, int, interface{}) ([]Variant, error)
+	ListWithPagination(int, interface{}) ([]Variant, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, int, interface{}) ([]Variant, *Pagination, error)
+	ListAll(int, interface{}) ([]Variant, error)
+	ListAllWithContext(context.Context, int, interface{}) ([]Variant, error)
 	Count(int, interface{}) (int, error)
+	CountWithContext(context.Context, int, interface{}) (int, error)
 	Get(int, interface{}) (*Variant, error)
+	GetWithContext(context.Context, int, interface{}) (*Variant, error)
 	Create(int, Variant) (*Variant, error)
+	CreateWithContext(context.Context, int, Variant) (*Variant, error)
 	Update(Variant) (*Variant, error)
+	UpdateWithContext(context.Context, Variant) (*Variant, error)
 	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
 }
 
 // VariantAPIOp handles communication with the variant related methods of
@@ -69,45 +80,99 @@ type VariantsResource struct {
 
 // List variants
 func (s *VariantAPIOp) List(productID int, options interface{}) ([]Variant, error) {
+	return s.ListWithContext(context.Background(), productID, options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *VariantAPIOp) ListWithContext(ctx context.Context, productID int, options interface{}) ([]Variant, error) {
 	path := fmt.Sprintf("%s/%d/variants.json", productsBasePath, productID)
 	resource := new(VariantsResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Variants, err
 }
 
+// ListWithPagination lists variants of a product and returns the pagination to request the
+// next and previous pages.
+func (s *VariantAPIOp) ListWithPagination(productID int, options interface{}) ([]Variant, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), productID, options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *VariantAPIOp) ListWithPaginationWithContext(ctx context.Context, productID int, options interface{}) ([]Variant, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/variants.json", productsBasePath, productID)
+	return listPage(ctx, s.client, path, options, func(r *VariantsResource) []Variant { return r.Variants })
+}
+
+// ListAll lists all variants of a product, following the pagination from the page
+// requested by options to the last page.
+func (s *VariantAPIOp) ListAll(productID int, options interface{}) ([]Variant, error) {
+	return s.ListAllWithContext(context.Background(), productID, options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *VariantAPIOp) ListAllWithContext(ctx context.Context, productID int, options interface{}) ([]Variant, error) {
+	path := fmt.Sprintf("%s/%d/variants.json", productsBasePath, productID)
+	return listAll(ctx, s.client, path, options, func(r *VariantsResource) []Variant { return r.Variants })
+}
+
 // Count variants
 func (s *VariantAPIOp) Count(productID int, options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), productID, options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *VariantAPIOp) CountWithContext(ctx context.Context, productID int, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/%d/variants/count.json", productsBasePath, productID)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual variant
 func (s *VariantAPIOp) Get(variantID int, options interface{}) (*Variant, error) {
+	return s.GetWithContext(context.Background(), variantID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *VariantAPIOp) GetWithContext(ctx context.Context, variantID int, options interface{}) (*Variant, error) {
 	path := fmt.Sprintf("%s/%d.json", variantsBasePath, variantID)
 	resource := new(VariantResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Variant, err
 }
 
 // Create a new variant
 func (s *VariantAPIOp) Create(productID int, variant Variant) (*Variant, error) {
+	return s.CreateWithContext(context.Background(), productID, variant)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *VariantAPIOp) CreateWithContext(ctx context.Context, productID int, variant Variant) (*Variant, error) {
 	path := fmt.Sprintf("%s/%d/variants.json", productsBasePath, productID)
 	wrappedData := VariantResource{Variant: &variant}
 	resource := new(VariantResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Variant, err
 }
 
 // Update existing variant
 func (s *VariantAPIOp) Update(variant Variant) (*Variant, error) {
+	return s.UpdateWithContext(context.Background(), variant)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *VariantAPIOp) UpdateWithContext(ctx context.Context, variant Variant) (*Variant, error) {
 	path := fmt.Sprintf("%s/%d.json", variantsBasePath, variant.ID)
 	wrappedData := VariantResource{Variant: &variant}
 	resource := new(VariantResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Variant, err
 }
 
 // Delete an existing product
 func (s *VariantAPIOp) Delete(productID int, variantID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d/variants/%d.json", productsBasePath, productID, variantID))
+	return s.DeleteWithContext(context.Background(), productID, variantID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *VariantAPIOp) DeleteWithContext(ctx context.Context, productID int, variantID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d/variants/%d.json", productsBasePath, productID, variantID))
 }
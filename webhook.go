@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -12,11 +13,25 @@ const webhooksBasePath = "admin/webhooks"
 // See: https://help.shopify.com/api/reference/webhook
 type WebhookAPI interface {
 	List(interface{}) ([]Webhook, error)
+	ListWithContext(context.Context, interface{}) ([]Webhook, error)
+	ListWithPagination(interface{}) ([]Webhook, *Pagination, error)
+	ListWithPaginationWithContext(context.Context, interface{}) ([]Webhook, *Pagination, error)
+	ListAll(interface{}) ([]Webhook, error)
+	ListAllWithContext(context.Context, interface{}) ([]Webhook, error)
 	Count(interface{}) (int, error)
+	CountWithContext(context.Context, interface{}) (int, error)
 	Get(int, interface{}) (*Webhook, error)
+	GetWithContext(context.Context, int, interface{}) (*Webhook, error)
 	Create(Webhook) (*Webhook, error)
+	CreateWithContext(context.Context, Webhook) (*Webhook, error)
 	Update(Webhook) (*Webhook, error)
+	UpdateWithContext(context.Context, Webhook) (*Webhook, error)
 	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	Sync([]Webhook) (SyncReport, error)
+	SyncWithContext(context.Context, []Webhook) (SyncReport, error)
+	SyncWithOptions([]Webhook, SyncOptions) (SyncReport, error)
+	SyncWithOptionsWithContext(context.Context, []Webhook, SyncOptions) (SyncReport, error)
 }
 
 // WebhookAPIOp handles communication with the webhook-related methods of
@@ -55,45 +70,99 @@ type WebhooksResource struct {
 
 // List webhooks
 func (s *WebhookAPIOp) List(options interface{}) ([]Webhook, error) {
+	return s.ListWithContext(context.Background(), options)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *WebhookAPIOp) ListWithContext(ctx context.Context, options interface{}) ([]Webhook, error) {
 	path := fmt.Sprintf("%s.json", webhooksBasePath)
 	resource := new(WebhooksResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Webhooks, err
 }
 
+// ListWithPagination lists webhooks and returns the pagination to request the
+// next and previous pages.
+func (s *WebhookAPIOp) ListWithPagination(options interface{}) ([]Webhook, *Pagination, error) {
+	return s.ListWithPaginationWithContext(context.Background(), options)
+}
+
+// ListWithPaginationWithContext is the context-aware variant of ListWithPagination.
+func (s *WebhookAPIOp) ListWithPaginationWithContext(ctx context.Context, options interface{}) ([]Webhook, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", webhooksBasePath)
+	return listPage(ctx, s.client, path, options, func(r *WebhooksResource) []Webhook { return r.Webhooks })
+}
+
+// ListAll lists all webhooks, following the pagination from the page
+// requested by options to the last page.
+func (s *WebhookAPIOp) ListAll(options interface{}) ([]Webhook, error) {
+	return s.ListAllWithContext(context.Background(), options)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *WebhookAPIOp) ListAllWithContext(ctx context.Context, options interface{}) ([]Webhook, error) {
+	path := fmt.Sprintf("%s.json", webhooksBasePath)
+	return listAll(ctx, s.client, path, options, func(r *WebhooksResource) []Webhook { return r.Webhooks })
+}
+
 // Count webhooks
 func (s *WebhookAPIOp) Count(options interface{}) (int, error) {
+	return s.CountWithContext(context.Background(), options)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *WebhookAPIOp) CountWithContext(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", webhooksBasePath)
-	return s.client.Count(path, options)
+	return s.client.CountWithContext(ctx, path, options)
 }
 
 // Get individual webhook
 func (s *WebhookAPIOp) Get(webhookdID int, options interface{}) (*Webhook, error) {
+	return s.GetWithContext(context.Background(), webhookdID, options)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *WebhookAPIOp) GetWithContext(ctx context.Context, webhookdID int, options interface{}) (*Webhook, error) {
 	path := fmt.Sprintf("%s/%d.json", webhooksBasePath, webhookdID)
 	resource := new(WebhookResource)
-	err := s.client.Get(path, resource, options)
+	err := s.client.GetWithContext(ctx, path, resource, options)
 	return resource.Webhook, err
 }
 
 // Create a new webhook
 func (s *WebhookAPIOp) Create(webhook Webhook) (*Webhook, error) {
+	return s.CreateWithContext(context.Background(), webhook)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *WebhookAPIOp) CreateWithContext(ctx context.Context, webhook Webhook) (*Webhook, error) {
 	path := fmt.Sprintf("%s.json", webhooksBasePath)
 	wrappedData := WebhookResource{Webhook: &webhook}
 	resource := new(WebhookResource)
-	err := s.client.Post(path, wrappedData, resource)
+	err := s.client.PostWithContext(ctx, path, wrappedData, resource)
 	return resource.Webhook, err
 }
 
 // Update an existing webhook.
 func (s *WebhookAPIOp) Update(webhook Webhook) (*Webhook, error) {
+	return s.UpdateWithContext(context.Background(), webhook)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *WebhookAPIOp) UpdateWithContext(ctx context.Context, webhook Webhook) (*Webhook, error) {
 	path := fmt.Sprintf("%s/%d.json", webhooksBasePath, webhook.ID)
 	wrappedData := WebhookResource{Webhook: &webhook}
 	resource := new(WebhookResource)
-	err := s.client.Put(path, wrappedData, resource)
+	err := s.client.PutWithContext(ctx, path, wrappedData, resource)
 	return resource.Webhook, err
 }
 
 // Delete an existing webhooks
 func (s *WebhookAPIOp) Delete(ID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", webhooksBasePath, ID))
+	return s.DeleteWithContext(context.Background(), ID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *WebhookAPIOp) DeleteWithContext(ctx context.Context, ID int) error {
+	return s.client.DeleteWithContext(ctx, fmt.Sprintf("%s/%d.json", webhooksBasePath, ID))
 }
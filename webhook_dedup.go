@@ -0,0 +1,195 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	webhookIDHeader          = "X-Shopify-Webhook-Id"
+	webhookTriggeredAtHeader = "X-Shopify-Triggered-At"
+)
+
+// defaultWebhookDedupTTL covers the 48 hours during which Shopify retries a
+// failed delivery.
+const defaultWebhookDedupTTL = 48 * time.Hour
+
+var (
+	// ErrWebhookDuplicate is returned for a delivery whose webhook ID has
+	// been processed before.
+	ErrWebhookDuplicate = errors.New("duplicate webhook delivery")
+
+	// ErrWebhookStale is returned for a delivery that was triggered longer
+	// ago than the deduplicator's MaxAge, or whose trigger time is missing or
+	// malformed while a MaxAge is set.
+	ErrWebhookStale = errors.New("stale webhook delivery")
+)
+
+// WebhookDedupStore remembers the IDs of webhook deliveries. Implementations
+// must be safe for concurrent use.
+type WebhookDedupStore interface {
+	// Add records id and reports whether it was added, i.e. false if id was
+	// already recorded.
+	Add(ctx context.Context, id string) (bool, error)
+
+	// Remove forgets id, so that a retry of the delivery is processed.
+	Remove(ctx context.Context, id string) error
+}
+
+// MemoryWebhookDedupStore is a WebhookDedupStore that remembers IDs in
+// memory for a fixed time to live.
+type MemoryWebhookDedupStore struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	expires map[string]time.Time
+	sweptAt time.Time
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+}
+
+// NewMemoryWebhookDedupStore returns an empty MemoryWebhookDedupStore
+// remembering IDs for ttl. A ttl of zero remembers IDs for 48 hours, the time
+// Shopify retries failed deliveries for.
+func NewMemoryWebhookDedupStore(ttl time.Duration) *MemoryWebhookDedupStore {
+	if ttl <= 0 {
+		ttl = defaultWebhookDedupTTL
+	}
+	return &MemoryWebhookDedupStore{
+		ttl:     ttl,
+		expires: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// Add records id unless it is recorded and not yet expired.
+func (s *MemoryWebhookDedupStore) Add(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+	if expires, ok := s.expires[id]; ok && now.Before(expires) {
+		return false, nil
+	}
+	s.expires[id] = now.Add(s.ttl)
+	return true, nil
+}
+
+// Remove forgets id.
+func (s *MemoryWebhookDedupStore) Remove(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.expires, id)
+	return nil
+}
+
+// sweep drops expired IDs, at most once per time to live.
+// It must be called with s.mu held.
+func (s *MemoryWebhookDedupStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < s.ttl {
+		return
+	}
+	for id, expires := range s.expires {
+		if !now.Before(expires) {
+			delete(s.expires, id)
+		}
+	}
+	s.sweptAt = now
+}
+
+// WebhookDeduplicator filters out webhook deliveries that were processed
+// before, keyed by their X-Shopify-Webhook-Id header, and optionally those
+// triggered too long ago according to their X-Shopify-Triggered-At header.
+// Deliveries must be verified before they are checked, otherwise forged
+// deliveries could claim the IDs of genuine ones.
+type WebhookDeduplicator struct {
+	// Store remembers the IDs of processed deliveries. IDs are remembered in
+	// memory for 48 hours when it is nil.
+	Store WebhookDedupStore
+
+	// MaxAge rejects deliveries triggered longer ago, e.g. replays of old
+	// deliveries, and deliveries without a valid trigger time. Zero accepts
+	// deliveries of any age.
+	MaxAge time.Duration
+
+	// memoryStore is used when Store is nil.
+	memoryStore     *MemoryWebhookDedupStore
+	memoryStoreOnce sync.Once
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+}
+
+// NewWebhookDeduplicator returns a WebhookDeduplicator backed by store. A nil
+// store remembers IDs in memory for 48 hours.
+func NewWebhookDeduplicator(store WebhookDedupStore) *WebhookDeduplicator {
+	if store == nil {
+		store = NewMemoryWebhookDedupStore(0)
+	}
+	return &WebhookDeduplicator{Store: store, now: time.Now}
+}
+
+// Check claims the delivery of req. It returns ErrWebhookStale or
+// ErrWebhookDuplicate if the delivery should be skipped. Deliveries without a
+// webhook ID cannot be deduplicated and are always accepted. If processing
+// the accepted delivery fails, Release it so that Shopify's retry is
+// processed.
+func (d *WebhookDeduplicator) Check(req *http.Request) error {
+	if d.MaxAge > 0 {
+		triggeredAt, err := time.Parse(time.RFC3339Nano, req.Header.Get(webhookTriggeredAtHeader))
+		if err != nil || d.currentTime().Sub(triggeredAt) > d.MaxAge {
+			return ErrWebhookStale
+		}
+	}
+
+	id := req.Header.Get(webhookIDHeader)
+	if id == "" {
+		return nil
+	}
+
+	added, err := d.store().Add(req.Context(), id)
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrWebhookDuplicate
+	}
+	return nil
+}
+
+// Release forgets the delivery of req, which was accepted by Check but could
+// not be processed. It is not bound to the context of req, which may already
+// be canceled.
+func (d *WebhookDeduplicator) Release(req *http.Request) error {
+	id := req.Header.Get(webhookIDHeader)
+	if id == "" {
+		return nil
+	}
+	return d.store().Remove(context.Background(), id)
+}
+
+// store returns the Store of the deduplicator, or an in-memory store if it
+// has none.
+func (d *WebhookDeduplicator) store() WebhookDedupStore {
+	if d.Store != nil {
+		return d.Store
+	}
+	d.memoryStoreOnce.Do(func() {
+		d.memoryStore = NewMemoryWebhookDedupStore(0)
+	})
+	return d.memoryStore
+}
+
+// currentTime returns the current time, also for deduplicators that were not
+// created with NewWebhookDeduplicator.
+func (d *WebhookDeduplicator) currentTime() time.Time {
+	if d.now == nil {
+		return time.Now()
+	}
+	return d.now()
+}
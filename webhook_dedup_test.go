@@ -0,0 +1,153 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMemoryWebhookDedupStore(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	store := NewMemoryWebhookDedupStore(time.Hour)
+	store.now = clock.now
+	ctx := context.Background()
+
+	if added, _ := store.Add(ctx, "a"); !added {
+		t.Errorf("MemoryWebhookDedupStore.Add of a new id = false, expected true")
+	}
+	if added, _ := store.Add(ctx, "a"); added {
+		t.Errorf("MemoryWebhookDedupStore.Add of a recorded id = true, expected false")
+	}
+
+	store.Remove(ctx, "a")
+	if added, _ := store.Add(ctx, "a"); !added {
+		t.Errorf("MemoryWebhookDedupStore.Add of a removed id = false, expected true")
+	}
+
+	clock.advance(time.Hour)
+	if added, _ := store.Add(ctx, "a"); !added {
+		t.Errorf("MemoryWebhookDedupStore.Add of an expired id = false, expected true")
+	}
+
+	store.Add(ctx, "b")
+	clock.advance(2 * time.Hour)
+	store.Add(ctx, "c")
+	if len(store.expires) != 1 {
+		t.Errorf("MemoryWebhookDedupStore kept %d ids, expected the expired ones to be swept", len(store.expires))
+	}
+}
+
+func TestWebhookDeduplicatorCheck(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2019, time.January, 1, 12, 0, 0, 0, time.UTC)}
+	dedup := NewWebhookDeduplicator(nil)
+	dedup.now = clock.now
+	dedup.MaxAge = 5 * time.Minute
+
+	newRequest := func(id, triggeredAt string) *http.Request {
+		req := httptest.NewRequest("POST", "https://example.com/webhooks", nil)
+		if id != "" {
+			req.Header.Set("X-Shopify-Webhook-Id", id)
+		}
+		if triggeredAt != "" {
+			req.Header.Set("X-Shopify-Triggered-At", triggeredAt)
+		}
+		return req
+	}
+
+	cases := []struct {
+		description string
+		req         *http.Request
+		expected    error
+	}{
+		{"first delivery", newRequest("b54557e4", "2019-01-01T11:59:00.123456789Z"), nil},
+		{"redelivery", newRequest("b54557e4", "2019-01-01T11:59:00.123456789Z"), ErrWebhookDuplicate},
+		{"stale delivery", newRequest("c31a9f02", "2019-01-01T11:50:00Z"), ErrWebhookStale},
+		{"delivery without id", newRequest("", "2019-01-01T11:59:00Z"), nil},
+		{"another delivery without id", newRequest("", "2019-01-01T11:59:00Z"), nil},
+		{"delivery without trigger time", newRequest("d9e0a1b7", ""), ErrWebhookStale},
+		{"delivery with a malformed trigger time", newRequest("d9e0a1b7", "yesterday"), ErrWebhookStale},
+	}
+
+	for _, c := range cases {
+		if err := dedup.Check(c.req); err != c.expected {
+			t.Errorf("WebhookDeduplicator.Check of %s = %v, expected %v", c.description, err, c.expected)
+		}
+	}
+
+	// A released delivery is processed again
+	dedup.Release(newRequest("b54557e4", ""))
+	if err := dedup.Check(newRequest("b54557e4", "2019-01-01T11:59:00Z")); err != nil {
+		t.Errorf("WebhookDeduplicator.Check of a released delivery = %v, expected nil", err)
+	}
+}
+
+func TestWebhookDeduplicatorZeroValue(t *testing.T) {
+	var dedup WebhookDeduplicator
+
+	req := httptest.NewRequest("POST", "https://example.com/webhooks", nil)
+	req.Header.Set("X-Shopify-Webhook-Id", "b54557e4")
+
+	if err := dedup.Check(req); err != nil {
+		t.Errorf("WebhookDeduplicator.Check of a first delivery without trigger time = %v, expected nil", err)
+	}
+	if err := dedup.Check(req); err != ErrWebhookDuplicate {
+		t.Errorf("WebhookDeduplicator.Check of a redelivery = %v, expected %v", err, ErrWebhookDuplicate)
+	}
+	if err := dedup.Release(req); err != nil {
+		t.Errorf("WebhookDeduplicator.Release returned error: %v", err)
+	}
+	if err := dedup.Check(req); err != nil {
+		t.Errorf("WebhookDeduplicator.Check of a released delivery = %v, expected nil", err)
+	}
+}
+
+func TestWebhookRouterDedup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := NewWebhookRouter(app)
+	router.Dedup = NewWebhookDeduplicator(NewMemoryWebhookDedupStore(0))
+
+	var skipped []error
+	router.OnError = func(r *http.Request, err error) {
+		if err == ErrWebhookDuplicate {
+			skipped = append(skipped, err)
+		}
+	}
+
+	calls := 0
+	router.OnOrderCreate(func(ctx context.Context, shop string, order Order) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database is down")
+		}
+		return nil
+	})
+
+	deliver := func() int {
+		req := newWebhookRequest(app.APISecret, "orders/create", `{"id":1}`)
+		req.Header.Set("X-Shopify-Webhook-Id", "b54557e4")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// The first delivery fails and is released, so the retry is processed.
+	// The redelivery of the processed webhook is acknowledged and skipped.
+	expected := []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK}
+	for i, status := range expected {
+		if code := deliver(); code != status {
+			t.Errorf("WebhookRouter responded %d to delivery %d, expected %d", code, i+1, status)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("WebhookRouter called the callback %d times, expected 2", calls)
+	}
+	if len(skipped) != 1 {
+		t.Errorf("WebhookRouter reported %d duplicates, expected 1", len(skipped))
+	}
+}
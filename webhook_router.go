@@ -0,0 +1,277 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	webhookTopicHeader      = "X-Shopify-Topic"
+	webhookShopDomainHeader = "X-Shopify-Shop-Domain"
+)
+
+// WebhookFunc handles the raw payload of a webhook delivered for shop.
+// Returning an error makes Shopify retry the delivery.
+type WebhookFunc func(ctx context.Context, shop string, payload []byte) error
+
+// OrderWebhookFunc handles an order webhook delivered for shop.
+type OrderWebhookFunc func(ctx context.Context, shop string, order Order) error
+
+// ProductWebhookFunc handles a product webhook delivered for shop.
+type ProductWebhookFunc func(ctx context.Context, shop string, product Product) error
+
+// CustomerWebhookFunc handles a customer webhook delivered for shop.
+type CustomerWebhookFunc func(ctx context.Context, shop string, customer Customer) error
+
+// ShopWebhookFunc handles a shop or app webhook delivered for shop.
+type ShopWebhookFunc func(ctx context.Context, shop string, s Shop) error
+
+// WebhookRouter is an http.Handler receiving Shopify webhooks. It verifies
+// the HMAC of every delivery with the app's secret and dispatches it to the
+// callback registered for its X-Shopify-Topic.
+//
+// The router responds with
+//   - 401 Unauthorized if the HMAC is invalid,
+//   - 400 Bad Request if the payload cannot be decoded,
+//   - 500 Internal Server Error if the callback returns an error, so that
+//     Shopify retries the delivery,
+//   - 200 OK otherwise, including for topics without a callback and for
+//     deliveries skipped by Dedup.
+//
+// Callbacks must be registered before the router starts serving requests.
+type WebhookRouter struct {
+	app      App
+	handlers map[string]WebhookFunc
+
+	// Dedup skips duplicate and stale deliveries if set. Deliveries whose
+	// callback fails are released, so that Shopify's retry is processed.
+	Dedup *WebhookDeduplicator
+
+	// OnError is called with the errors of rejected, skipped and failed
+	// deliveries, e.g. to log them. It is optional.
+	OnError func(r *http.Request, err error)
+}
+
+// webhookPayloadError is returned by typed callbacks if the payload of a
+// delivery cannot be decoded. Retrying such a delivery would not help.
+type webhookPayloadError struct {
+	err error
+}
+
+func (e webhookPayloadError) Error() string {
+	return "invalid webhook payload: " + e.err.Error()
+}
+
+// webhookUnauthorizedError is reported to OnError for deliveries with an
+// invalid HMAC.
+type webhookUnauthorizedError struct{}
+
+func (webhookUnauthorizedError) Error() string {
+	return "invalid webhook HMAC"
+}
+
+// NewWebhookRouter returns a WebhookRouter verifying deliveries with the
+// secret of app.
+func NewWebhookRouter(app App) *WebhookRouter {
+	return &WebhookRouter{
+		app:      app,
+		handlers: make(map[string]WebhookFunc),
+	}
+}
+
+// NewWebhookRouter returns a WebhookRouter verifying deliveries with the
+// secret of the app.
+// a.NewWebhookRouter() is equivalent to NewWebhookRouter(a)
+func (app App) NewWebhookRouter() *WebhookRouter {
+	return NewWebhookRouter(app)
+}
+
+// Handle registers a callback receiving the raw payload of topic, e.g.
+// "orders/create". It replaces any callback registered for the topic before.
+func (r *WebhookRouter) Handle(topic string, f WebhookFunc) {
+	r.handlers[topic] = f
+}
+
+// HandleOrder registers a callback receiving the order of topic.
+func (r *WebhookRouter) HandleOrder(topic string, f OrderWebhookFunc) {
+	r.Handle(topic, func(ctx context.Context, shop string, payload []byte) error {
+		var order Order
+		if err := json.Unmarshal(payload, &order); err != nil {
+			return webhookPayloadError{err}
+		}
+		return f(ctx, shop, order)
+	})
+}
+
+// HandleProduct registers a callback receiving the product of topic.
+func (r *WebhookRouter) HandleProduct(topic string, f ProductWebhookFunc) {
+	r.Handle(topic, func(ctx context.Context, shop string, payload []byte) error {
+		var product Product
+		if err := json.Unmarshal(payload, &product); err != nil {
+			return webhookPayloadError{err}
+		}
+		return f(ctx, shop, product)
+	})
+}
+
+// HandleCustomer registers a callback receiving the customer of topic.
+func (r *WebhookRouter) HandleCustomer(topic string, f CustomerWebhookFunc) {
+	r.Handle(topic, func(ctx context.Context, shop string, payload []byte) error {
+		var customer Customer
+		if err := json.Unmarshal(payload, &customer); err != nil {
+			return webhookPayloadError{err}
+		}
+		return f(ctx, shop, customer)
+	})
+}
+
+// HandleShop registers a callback receiving the shop of topic.
+func (r *WebhookRouter) HandleShop(topic string, f ShopWebhookFunc) {
+	r.Handle(topic, func(ctx context.Context, shop string, payload []byte) error {
+		var s Shop
+		if err := json.Unmarshal(payload, &s); err != nil {
+			return webhookPayloadError{err}
+		}
+		return f(ctx, shop, s)
+	})
+}
+
+// OnOrderCreate registers a callback for the orders/create topic.
+func (r *WebhookRouter) OnOrderCreate(f OrderWebhookFunc) {
+	r.HandleOrder("orders/create", f)
+}
+
+// OnOrderUpdate registers a callback for the orders/updated topic.
+func (r *WebhookRouter) OnOrderUpdate(f OrderWebhookFunc) {
+	r.HandleOrder("orders/updated", f)
+}
+
+// OnOrderPaid registers a callback for the orders/paid topic.
+func (r *WebhookRouter) OnOrderPaid(f OrderWebhookFunc) {
+	r.HandleOrder("orders/paid", f)
+}
+
+// OnOrderCancelled registers a callback for the orders/cancelled topic.
+func (r *WebhookRouter) OnOrderCancelled(f OrderWebhookFunc) {
+	r.HandleOrder("orders/cancelled", f)
+}
+
+// OnOrderFulfilled registers a callback for the orders/fulfilled topic.
+func (r *WebhookRouter) OnOrderFulfilled(f OrderWebhookFunc) {
+	r.HandleOrder("orders/fulfilled", f)
+}
+
+// OnOrderDelete registers a callback for the orders/delete topic. Only the ID
+// of the order is set.
+func (r *WebhookRouter) OnOrderDelete(f OrderWebhookFunc) {
+	r.HandleOrder("orders/delete", f)
+}
+
+// OnProductCreate registers a callback for the products/create topic.
+func (r *WebhookRouter) OnProductCreate(f ProductWebhookFunc) {
+	r.HandleProduct("products/create", f)
+}
+
+// OnProductUpdate registers a callback for the products/update topic.
+func (r *WebhookRouter) OnProductUpdate(f ProductWebhookFunc) {
+	r.HandleProduct("products/update", f)
+}
+
+// OnProductDelete registers a callback for the products/delete topic. Only
+// the ID of the product is set.
+func (r *WebhookRouter) OnProductDelete(f ProductWebhookFunc) {
+	r.HandleProduct("products/delete", f)
+}
+
+// OnCustomerCreate registers a callback for the customers/create topic.
+func (r *WebhookRouter) OnCustomerCreate(f CustomerWebhookFunc) {
+	r.HandleCustomer("customers/create", f)
+}
+
+// OnCustomerUpdate registers a callback for the customers/update topic.
+func (r *WebhookRouter) OnCustomerUpdate(f CustomerWebhookFunc) {
+	r.HandleCustomer("customers/update", f)
+}
+
+// OnCustomerDelete registers a callback for the customers/delete topic. Only
+// the ID of the customer is set.
+func (r *WebhookRouter) OnCustomerDelete(f CustomerWebhookFunc) {
+	r.HandleCustomer("customers/delete", f)
+}
+
+// OnAppUninstalled registers a callback for the app/uninstalled topic.
+func (r *WebhookRouter) OnAppUninstalled(f ShopWebhookFunc) {
+	r.HandleShop("app/uninstalled", f)
+}
+
+// OnShopUpdate registers a callback for the shop/update topic.
+func (r *WebhookRouter) OnShopUpdate(f ShopWebhookFunc) {
+	r.HandleShop("shop/update", f)
+}
+
+// ServeHTTP verifies and dispatches a webhook delivery.
+func (r *WebhookRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !r.app.VerifyWebhookRequest(req) {
+		r.fail(w, req, http.StatusUnauthorized, webhookUnauthorizedError{})
+		return
+	}
+
+	payload, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		r.fail(w, req, http.StatusBadRequest, err)
+		return
+	}
+
+	handler, ok := r.handlers[req.Header.Get(webhookTopicHeader)]
+	if !ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Dedup != nil {
+		err := r.Dedup.Check(req)
+		if err == ErrWebhookDuplicate || err == ErrWebhookStale {
+			r.fail(w, req, http.StatusOK, err)
+			return
+		}
+		if err != nil {
+			r.fail(w, req, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	err = handler(req.Context(), req.Header.Get(webhookShopDomainHeader), payload)
+	if err != nil {
+		if r.Dedup != nil {
+			r.Dedup.Release(req)
+		}
+		status := http.StatusInternalServerError
+		if _, ok := err.(webhookPayloadError); ok {
+			status = http.StatusBadRequest
+		}
+		r.fail(w, req, status, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// fail reports err to OnError and responds with status.
+func (r *WebhookRouter) fail(w http.ResponseWriter, req *http.Request, status int, err error) {
+	if r.OnError != nil {
+		r.OnError(req, err)
+	}
+	if status == http.StatusOK {
+		w.WriteHeader(status)
+		return
+	}
+	http.Error(w, http.StatusText(status), status)
+}
@@ -0,0 +1,167 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newWebhookRequest returns a webhook delivery for topic signed with secret.
+func newWebhookRequest(secret, topic, payload string) *http.Request {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+
+	req := httptest.NewRequest("POST", "https://example.com/webhooks", bytes.NewBufferString(payload))
+	req.Header.Set("X-Shopify-Hmac-Sha256", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	req.Header.Set("X-Shopify-Topic", topic)
+	req.Header.Set("X-Shopify-Shop-Domain", "fooshop.myshopify.com")
+	return req
+}
+
+func TestWebhookRouterOrderCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := app.NewWebhookRouter()
+
+	var received Order
+	var receivedShop string
+	router.OnOrderCreate(func(ctx context.Context, shop string, order Order) error {
+		receivedShop = shop
+		received = order
+		return nil
+	})
+
+	// Webhooks deliver the order without the resource root
+	var fixture map[string]json.RawMessage
+	if err := json.Unmarshal(loadFixture("order.json"), &fixture); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newWebhookRequest(app.APISecret, "orders/create", string(fixture["order"])))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("WebhookRouter responded %d, expected %d", rec.Code, http.StatusOK)
+	}
+	if receivedShop != "fooshop.myshopify.com" {
+		t.Errorf("OnOrderCreate received shop %q, expected %q", receivedShop, "fooshop.myshopify.com")
+	}
+	orderTests(t, received)
+}
+
+func TestWebhookRouterTopics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := NewWebhookRouter(app)
+
+	var topics []string
+	record := func(topic string) {
+		topics = append(topics, topic)
+	}
+	router.OnOrderUpdate(func(ctx context.Context, shop string, order Order) error {
+		record("orders/updated")
+		return nil
+	})
+	router.OnProductDelete(func(ctx context.Context, shop string, product Product) error {
+		if product.ID != 1 {
+			t.Errorf("OnProductDelete received product %d, expected 1", product.ID)
+		}
+		record("products/delete")
+		return nil
+	})
+	router.OnCustomerCreate(func(ctx context.Context, shop string, customer Customer) error {
+		record("customers/create")
+		return nil
+	})
+	router.OnAppUninstalled(func(ctx context.Context, shop string, s Shop) error {
+		if s.MyshopifyDomain != "fooshop.myshopify.com" {
+			t.Errorf("OnAppUninstalled received shop %q, expected %q", s.MyshopifyDomain, "fooshop.myshopify.com")
+		}
+		record("app/uninstalled")
+		return nil
+	})
+
+	deliveries := []struct {
+		topic   string
+		payload string
+	}{
+		{"orders/updated", `{"id":1}`},
+		{"products/delete", `{"id":1}`},
+		{"customers/create", `{"id":1}`},
+		{"app/uninstalled", `{"id":1,"myshopify_domain":"fooshop.myshopify.com"}`},
+		{"carts/create", `{"id":"abc"}`},
+	}
+
+	for _, d := range deliveries {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, newWebhookRequest(app.APISecret, d.topic, d.payload))
+		if rec.Code != http.StatusOK {
+			t.Errorf("WebhookRouter responded %d to %s, expected %d", rec.Code, d.topic, http.StatusOK)
+		}
+	}
+
+	expected := []string{"orders/updated", "products/delete", "customers/create", "app/uninstalled"}
+	if len(topics) != len(expected) {
+		t.Fatalf("WebhookRouter dispatched %v, expected %v", topics, expected)
+	}
+	for i := range expected {
+		if topics[i] != expected[i] {
+			t.Errorf("WebhookRouter dispatched %v, expected %v", topics, expected)
+			break
+		}
+	}
+}
+
+func TestWebhookRouterErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := NewWebhookRouter(app)
+
+	var reported []error
+	router.OnError = func(r *http.Request, err error) {
+		reported = append(reported, err)
+	}
+
+	called := false
+	router.OnOrderCreate(func(ctx context.Context, shop string, order Order) error {
+		called = true
+		return errors.New("database is down")
+	})
+
+	cases := []struct {
+		description string
+		req         *http.Request
+		expected    int
+	}{
+		{"invalid HMAC", newWebhookRequest("wrong secret", "orders/create", `{"id":1}`), http.StatusUnauthorized},
+		{"invalid payload", newWebhookRequest(app.APISecret, "orders/create", `{"id":"one"}`), http.StatusBadRequest},
+		{"callback error", newWebhookRequest(app.APISecret, "orders/create", `{"id":1}`), http.StatusInternalServerError},
+		{"wrong method", httptest.NewRequest("GET", "https://example.com/webhooks", nil), http.StatusMethodNotAllowed},
+	}
+
+	for _, c := range cases {
+		called = false
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("WebhookRouter responded %d to %s, expected %d", rec.Code, c.description, c.expected)
+		}
+		if c.expected == http.StatusUnauthorized && called {
+			t.Errorf("WebhookRouter called the callback for %s", c.description)
+		}
+	}
+
+	if len(reported) != 3 {
+		t.Errorf("WebhookRouter reported %d errors to OnError, expected 3", len(reported))
+	}
+}
@@ -0,0 +1,165 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// defaultWebhookFormat is the format Shopify delivers webhooks in if none is
+// requested.
+const defaultWebhookFormat = "json"
+
+// SyncOptions control how WebhookAPI.SyncWithOptions converges the webhooks of
+// a shop.
+type SyncOptions struct {
+	// DryRun only plans the changes without applying them.
+	DryRun bool
+
+	// DeleteExtras deletes existing webhooks that are not desired.
+	DeleteExtras bool
+}
+
+// SyncReport lists the changes made, or planned in a dry run, by a webhook
+// sync. Webhooks are identified by their topic and address.
+type SyncReport struct {
+	DryRun bool
+
+	// Created lists the desired webhooks that did not exist.
+	Created []Webhook
+
+	// Updated lists the webhooks whose format, fields or metafield
+	// namespaces drifted from the desired ones.
+	Updated []Webhook
+
+	// Deleted lists the existing webhooks that are not desired. They are
+	// only deleted with DeleteExtras.
+	Deleted []Webhook
+
+	// Unchanged lists the existing webhooks that match the desired ones.
+	Unchanged []Webhook
+}
+
+// webhookKey identifies a webhook subscription.
+type webhookKey struct {
+	topic   string
+	address string
+}
+
+// Sync converges the webhooks of the shop onto desired. Missing webhooks are
+// created and drifted ones are updated. Webhooks that are not desired are
+// kept, see SyncWithOptions to delete them.
+func (s *WebhookAPIOp) Sync(desired []Webhook) (SyncReport, error) {
+	return s.SyncWithOptionsWithContext(context.Background(), desired, SyncOptions{})
+}
+
+// SyncWithContext is the context-aware variant of Sync.
+func (s *WebhookAPIOp) SyncWithContext(ctx context.Context, desired []Webhook) (SyncReport, error) {
+	return s.SyncWithOptionsWithContext(ctx, desired, SyncOptions{})
+}
+
+// SyncWithOptions converges the webhooks of the shop onto desired like Sync,
+// optionally deleting extra webhooks or only planning the changes. If a
+// change fails, the report lists the changes made before it.
+func (s *WebhookAPIOp) SyncWithOptions(desired []Webhook, options SyncOptions) (SyncReport, error) {
+	return s.SyncWithOptionsWithContext(context.Background(), desired, options)
+}
+
+// SyncWithOptionsWithContext is the context-aware variant of SyncWithOptions.
+func (s *WebhookAPIOp) SyncWithOptionsWithContext(ctx context.Context, desired []Webhook, options SyncOptions) (SyncReport, error) {
+	report := SyncReport{DryRun: options.DryRun}
+
+	existing, err := s.ListAllWithContext(ctx, ListOptions{Limit: 250})
+	if err != nil {
+		return report, err
+	}
+
+	current := make(map[webhookKey]Webhook, len(existing))
+	for _, webhook := range existing {
+		current[webhookKey{webhook.Topic, webhook.Address}] = webhook
+	}
+
+	wanted := make(map[webhookKey]bool, len(desired))
+	for _, webhook := range desired {
+		key := webhookKey{webhook.Topic, webhook.Address}
+		if wanted[key] {
+			return report, fmt.Errorf("duplicate desired webhook %s %s", webhook.Topic, webhook.Address)
+		}
+		wanted[key] = true
+	}
+
+	for _, webhook := range desired {
+		old, ok := current[webhookKey{webhook.Topic, webhook.Address}]
+		switch {
+		case !ok:
+			if !options.DryRun {
+				created, err := s.CreateWithContext(ctx, webhook)
+				if err != nil {
+					return report, err
+				}
+				webhook = *created
+			}
+			report.Created = append(report.Created, webhook)
+		case webhookDrifted(old, webhook):
+			webhook.ID = old.ID
+			if !options.DryRun {
+				updated, err := s.UpdateWithContext(ctx, webhook)
+				if err != nil {
+					return report, err
+				}
+				webhook = *updated
+			}
+			report.Updated = append(report.Updated, webhook)
+		default:
+			report.Unchanged = append(report.Unchanged, old)
+		}
+	}
+
+	if !options.DeleteExtras {
+		return report, nil
+	}
+
+	for _, webhook := range existing {
+		if wanted[webhookKey{webhook.Topic, webhook.Address}] {
+			continue
+		}
+		if !options.DryRun {
+			if err := s.DeleteWithContext(ctx, webhook.ID); err != nil {
+				return report, err
+			}
+		}
+		report.Deleted = append(report.Deleted, webhook)
+	}
+
+	return report, nil
+}
+
+// webhookDrifted reports whether the existing webhook differs from the
+// desired one. The order of fields and metafield namespaces does not matter.
+func webhookDrifted(existing, desired Webhook) bool {
+	format := desired.Format
+	if format == "" {
+		format = defaultWebhookFormat
+	}
+	return existing.Format != format ||
+		!sameStrings(existing.Fields, desired.Fields) ||
+		!sameStrings(existing.MetafieldNamespaces, desired.MetafieldNamespaces)
+}
+
+// sameStrings reports whether a and b hold the same strings in any order. Nil
+// and empty slices are the same.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,210 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+const webhookSyncExisting = `{"webhooks": [
+	{"id": 1, "address": "https://example.com/hooks", "topic": "orders/create", "format": "json", "fields": ["id", "updated_at"], "metafield_namespaces": []},
+	{"id": 2, "address": "https://example.com/hooks", "topic": "orders/updated", "format": "json", "fields": [], "metafield_namespaces": []},
+	{"id": 3, "address": "https://example.com/hooks", "topic": "app/uninstalled", "format": "json", "fields": [], "metafield_namespaces": []}
+]}`
+
+// webhookSyncDesired is unchanged for orders/create, drifted for
+// orders/updated and missing products/create.
+var webhookSyncDesired = []Webhook{
+	{Address: "https://example.com/hooks", Topic: "orders/create", Fields: []string{"updated_at", "id"}},
+	{Address: "https://example.com/hooks", Topic: "orders/updated", Format: "xml"},
+	{Address: "https://example.com/hooks", Topic: "products/create", Format: "json"},
+}
+
+// webhookSyncCalls records the changing calls made by a sync.
+type webhookSyncCalls struct {
+	created []Webhook
+	updated []Webhook
+	deleted int
+}
+
+func registerWebhookSyncResponders(calls *webhookSyncCalls) {
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/webhooks.json",
+		httpmock.NewStringResponder(200, webhookSyncExisting))
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/webhooks.json",
+		func(req *http.Request) (*http.Response, error) {
+			resource := new(WebhookResource)
+			if err := json.NewDecoder(req.Body).Decode(resource); err != nil {
+				return nil, err
+			}
+			resource.Webhook.ID = 4
+			calls.created = append(calls.created, *resource.Webhook)
+			return httpmock.NewJsonResponse(201, resource)
+		})
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/webhooks/2.json",
+		func(req *http.Request) (*http.Response, error) {
+			resource := new(WebhookResource)
+			if err := json.NewDecoder(req.Body).Decode(resource); err != nil {
+				return nil, err
+			}
+			calls.updated = append(calls.updated, *resource.Webhook)
+			return httpmock.NewJsonResponse(200, resource)
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/webhooks/3.json",
+		func(req *http.Request) (*http.Response, error) {
+			calls.deleted++
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+}
+
+func webhookTopics(webhooks []Webhook) []string {
+	var topics []string
+	for _, webhook := range webhooks {
+		topics = append(topics, webhook.Topic)
+	}
+	return topics
+}
+
+func TestWebhookSync(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := new(webhookSyncCalls)
+	registerWebhookSyncResponders(calls)
+
+	report, err := client.Webhook.Sync(webhookSyncDesired)
+	if err != nil {
+		t.Fatalf("Webhook.Sync returned error: %v", err)
+	}
+
+	if len(calls.created) != 1 || calls.created[0].Topic != "products/create" {
+		t.Errorf("Webhook.Sync created %+v, expected products/create", calls.created)
+	}
+	if len(calls.updated) != 1 || calls.updated[0].ID != 2 || calls.updated[0].Format != "xml" {
+		t.Errorf("Webhook.Sync updated %+v, expected webhook 2 with format xml", calls.updated)
+	}
+	if calls.deleted != 0 {
+		t.Errorf("Webhook.Sync deleted %d webhooks, expected none", calls.deleted)
+	}
+
+	expected := SyncReport{
+		Created: []Webhook{{ID: 4, Address: "https://example.com/hooks", Topic: "products/create", Format: "json"}},
+		Updated: []Webhook{{ID: 2, Address: "https://example.com/hooks", Topic: "orders/updated", Format: "xml"}},
+		Unchanged: []Webhook{{
+			ID:                  1,
+			Address:             "https://example.com/hooks",
+			Topic:               "orders/create",
+			Format:              "json",
+			Fields:              []string{"id", "updated_at"},
+			MetafieldNamespaces: []string{},
+		}},
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Errorf("Webhook.Sync returned %+v, expected %+v", report, expected)
+	}
+}
+
+func TestWebhookSyncDeleteExtras(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := new(webhookSyncCalls)
+	registerWebhookSyncResponders(calls)
+
+	report, err := client.Webhook.SyncWithOptions(webhookSyncDesired, SyncOptions{DeleteExtras: true})
+	if err != nil {
+		t.Fatalf("Webhook.SyncWithOptions returned error: %v", err)
+	}
+
+	if calls.deleted != 1 {
+		t.Errorf("Webhook.SyncWithOptions deleted %d webhooks, expected 1", calls.deleted)
+	}
+	if len(report.Deleted) != 1 || report.Deleted[0].ID != 3 {
+		t.Errorf("Webhook.SyncWithOptions reported deleted %+v, expected webhook 3", report.Deleted)
+	}
+}
+
+func TestWebhookSyncDryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := new(webhookSyncCalls)
+	registerWebhookSyncResponders(calls)
+
+	report, err := client.Webhook.SyncWithOptions(webhookSyncDesired, SyncOptions{DryRun: true, DeleteExtras: true})
+	if err != nil {
+		t.Fatalf("Webhook.SyncWithOptions returned error: %v", err)
+	}
+
+	if len(calls.created) != 0 || len(calls.updated) != 0 || calls.deleted != 0 {
+		t.Errorf("Webhook.SyncWithOptions made changes in a dry run: %+v", calls)
+	}
+
+	if !report.DryRun {
+		t.Errorf("Webhook.SyncWithOptions returned DryRun false, expected true")
+	}
+
+	tests := []struct {
+		name     string
+		webhooks []Webhook
+		expected []string
+	}{
+		{"Created", report.Created, []string{"products/create"}},
+		{"Updated", report.Updated, []string{"orders/updated"}},
+		{"Deleted", report.Deleted, []string{"app/uninstalled"}},
+		{"Unchanged", report.Unchanged, []string{"orders/create"}},
+	}
+	for _, c := range tests {
+		if topics := webhookTopics(c.webhooks); !reflect.DeepEqual(topics, c.expected) {
+			t.Errorf("Webhook.SyncWithOptions planned %s %v, expected %v", c.name, topics, c.expected)
+		}
+	}
+
+	if report.Updated[0].ID != 2 {
+		t.Errorf("Webhook.SyncWithOptions planned update of webhook %d, expected 2", report.Updated[0].ID)
+	}
+}
+
+func TestWebhookSyncDuplicateDesired(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := new(webhookSyncCalls)
+	registerWebhookSyncResponders(calls)
+
+	desired := []Webhook{webhookSyncDesired[2], webhookSyncDesired[2]}
+	_, err := client.Webhook.Sync(desired)
+	if err == nil {
+		t.Errorf("Webhook.Sync with duplicate webhooks returned nil error")
+	}
+	if len(calls.created) != 0 {
+		t.Errorf("Webhook.Sync with duplicate webhooks created %+v, expected none", calls.created)
+	}
+}
+
+func TestWebhookDrifted(t *testing.T) {
+	existing := Webhook{Format: "json", Fields: []string{"id", "title"}, MetafieldNamespaces: nil}
+
+	cases := []struct {
+		desired  Webhook
+		expected bool
+	}{
+		{Webhook{Fields: []string{"title", "id"}, MetafieldNamespaces: []string{}}, false},
+		{Webhook{Format: "json", Fields: []string{"id", "title"}}, false},
+		{Webhook{Format: "xml", Fields: []string{"id", "title"}}, true},
+		{Webhook{Fields: []string{"id"}}, true},
+		{Webhook{Fields: []string{"id", "handle"}}, true},
+		{Webhook{Fields: []string{"id", "title"}, MetafieldNamespaces: []string{"google"}}, true},
+	}
+
+	for _, c := range cases {
+		if drifted := webhookDrifted(existing, c.desired); drifted != c.expected {
+			t.Errorf("webhookDrifted(%+v, %+v) returned %v, expected %v", existing, c.desired, drifted, c.expected)
+		}
+	}
+}